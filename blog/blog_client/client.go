@@ -1 +1,224 @@
-package blog_client
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/golang/protobuf/jsonpb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"grpc-go-course/blog/blogidem"
+	"grpc-go-course/blog/blogpb"
+	"grpc-go-course/config"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+const usage = `Usage: blog_client <command> [flags]
+
+Commands:
+  create          create a blog
+  get             show a blog
+  update          change the title, content or author of a blog
+  delete          move a blog to the trash
+  restore         take a deleted blog out of the trash
+  trash           list the deleted blogs
+  render          show a blog as sanitized HTML
+  publish         publish a blog now or schedule it
+  tags            count how many blogs use each tag
+  list            list blogs, one page at a time
+  create-author   create the profile of an author
+  import          create the blogs read from a JSON lines file
+  export          write every blog to a JSON lines file
+  upload          attach a file to a blog
+  download        save an attachment to a file
+
+Run "blog_client <command> -h" to see the flags of a command.
+`
+
+// connection flags shared by every command
+type connFlags struct {
+	config.Client
+}
+
+func (c *connFlags) register(fs *flag.FlagSet) {
+	c.Client = config.Client{
+		Address:  "localhost:50051",
+		CAFile:   "ssl/ca.crt",
+		LogLevel: "warning",
+	}
+	c.Client.Register(fs)
+}
+
+// dial connects to the blog server
+func (c *connFlags) dial() (*grpc.ClientConn, blogpb.BlogServiceClient) {
+	if err := config.SetupLogging(c.LogLevel); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
+	cc, err := c.Dial()
+	if err != nil {
+		log.Fatalf("Couldn't connect: %v", err)
+	}
+	return cc, blogpb.NewBlogServiceClient(cc)
+}
+
+// parse reads the flags of a command from args, the environment and the
+// config file, and returns the names of the flags given in args
+func parse(fs *flag.FlagSet, args []string) map[string]bool {
+	given, err := config.LoadGiven(fs, args, "BLOG_CLIENT_")
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	return given
+}
+
+// idempotency sends an idempotency key with the call of a command, so the
+// command can be run again after a network error without repeating the
+// change
+type idempotency struct {
+	key    string
+	header metadata.MD
+}
+
+func (i *idempotency) register(fs *flag.FlagSet) {
+	fs.StringVar(&i.key, "idempotency-key", "", "unique key making it safe to run the command again if it fails")
+}
+
+// call returns the context and options of the call carrying the key
+func (i *idempotency) call() (context.Context, grpc.CallOption) {
+	ctx := context.Background()
+	if i.key != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, blogidem.KeyHeader, i.key)
+	}
+	return ctx, grpc.Header(&i.header)
+}
+
+// report tells whether the server replayed the response of an earlier call
+func (i *idempotency) report() {
+	if len(i.header.Get(blogidem.ReplayedHeader)) > 0 {
+		fmt.Fprintf(os.Stderr, "Already done by an earlier call with idempotency key %q\n", i.key)
+	}
+}
+
+// output prints blogs either as JSON or as a table
+type output struct {
+	format string
+}
+
+func (o *output) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.format, "output", "table", "output format: table or json")
+}
+
+func (o *output) check() {
+	if o.format != "table" && o.format != "json" {
+		log.Fatalf("Unknown output format: %v", o.format)
+	}
+}
+
+// printBlogs writes blogs to stdout, as one JSON document per blog or as
+// a table with a header
+func (o *output) printBlogs(blogs ...*blogpb.Blog) {
+	if o.format == "json" {
+		m := jsonpb.Marshaler{OrigName: true, Indent: "  "}
+		for _, blog := range blogs {
+			data, err := m.MarshalToString(blog)
+			if err != nil {
+				log.Fatalf("error while printing blog: %v", err)
+			}
+			fmt.Println(data)
+		}
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tAUTHOR\tVERSION\tSTATUS\tTAGS\tTITLE\tCONTENT")
+	for _, blog := range blogs {
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\t%v\n",
+			blog.GetId(),
+			blog.GetAuthorId(),
+			blog.GetVersion(),
+			blog.GetStatus(),
+			strings.Join(blog.GetTags(), ","),
+			blog.GetTitle(),
+			excerpt(blog.GetContent(), 40),
+		)
+	}
+	w.Flush()
+}
+
+// printViolations writes the invalid fields reported by a gRPC error, if
+// any, to stderr
+func printViolations(err error) {
+	for _, detail := range status.Convert(err).Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, v := range badRequest.GetFieldViolations() {
+			fmt.Fprintf(os.Stderr, "%v: %v\n", v.GetField(), v.GetDescription())
+		}
+	}
+}
+
+// excerpt returns the first line of text cut to at most n characters
+func excerpt(text string, n int) string {
+	if i := strings.IndexByte(text, '\n'); i >= 0 {
+		text = text[:i] + "..."
+	}
+	runes := []rune(text)
+	if len(runes) > n {
+		return string(runes[:n-3]) + "..."
+	}
+	return text
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	command, args := os.Args[1], os.Args[2:]
+	switch command {
+	case "create":
+		doCreate(args)
+	case "get":
+		doGet(args)
+	case "update":
+		doUpdate(args)
+	case "delete":
+		doDelete(args)
+	case "restore":
+		doRestore(args)
+	case "trash":
+		doTrash(args)
+	case "render":
+		doRender(args)
+	case "publish":
+		doPublish(args)
+	case "tags":
+		doTags(args)
+	case "list":
+		doList(args)
+	case "create-author":
+		doCreateAuthor(args)
+	case "import":
+		doImport(args)
+	case "export":
+		doExport(args)
+	case "upload":
+		doUpload(args)
+	case "download":
+		doDownload(args)
+	case "help", "-h", "-help", "--help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n%v", command, usage)
+		os.Exit(2)
+	}
+}
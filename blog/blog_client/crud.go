@@ -0,0 +1,517 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"grpc-go-course/blog/blogpb"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// parseFormat returns the content format named on the command line
+func parseFormat(name string) blogpb.Blog_Format {
+	format, ok := blogpb.Blog_Format_value[strings.ToUpper(name)]
+	if !ok {
+		log.Fatalf("Unknown content format: %v", name)
+	}
+	return blogpb.Blog_Format(format)
+}
+
+// splitTags returns the tags of a comma separated list
+func splitTags(list string) []string {
+	var tags []string
+	for _, tag := range strings.Split(list, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// parseFields returns a field mask listing the comma separated fields,
+// or nil if there are none
+func parseFields(list string) *field_mask.FieldMask {
+	var paths []string
+	for _, field := range strings.Split(list, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			paths = append(paths, field)
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	return &field_mask.FieldMask{Paths: paths}
+}
+
+// readContent returns the content given with -content, or read from the
+// file given with -content-file ("-" for stdin)
+func readContent(content string, contentFile string) string {
+	if contentFile == "" {
+		return content
+	}
+	var data []byte
+	var err error
+	if contentFile == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(contentFile)
+	}
+	if err != nil {
+		log.Fatalf("Cannot read content: %v", err)
+	}
+	return string(data)
+}
+
+func doCreate(args []string) {
+	fs := flag.NewFlagSet("create", flag.ExitOnError)
+	var conn connFlags
+	var idem idempotency
+	var out output
+	conn.register(fs)
+	idem.register(fs)
+	out.register(fs)
+	authorID := fs.String("author", "", "id of the author of the blog (required)")
+	title := fs.String("title", "", "title of the blog")
+	content := fs.String("content", "", "content of the blog")
+	contentFile := fs.String("content-file", "", "read the content from this file, - for stdin")
+	format := fs.String("format", "plain", "format of the content: plain, markdown or html")
+	tags := fs.String("tags", "", "comma separated tags of the blog")
+	parse(fs, args)
+	out.check()
+
+	if *authorID == "" {
+		log.Fatalf("Missing -author")
+	}
+	contentFormat := parseFormat(*format)
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	ctx, header := idem.call()
+	res, err := c.CreateBlog(ctx, &blogpb.CreateBlogRequest{
+		Blog: &blogpb.Blog{
+			AuthorId:      *authorID,
+			Title:         *title,
+			Content:       readContent(*content, *contentFile),
+			ContentFormat: contentFormat,
+			Tags:          splitTags(*tags),
+		},
+	}, header)
+	if err != nil {
+		printViolations(err)
+		log.Fatalf("error while calling CreateBlog RPC: %v", err)
+	}
+	idem.report()
+	out.printBlogs(res.GetBlog())
+}
+
+func doGet(args []string) {
+	fs := flag.NewFlagSet("get", flag.ExitOnError)
+	var conn connFlags
+	var out output
+	conn.register(fs)
+	out.register(fs)
+	blogID := fs.String("id", "", "id of the blog (required)")
+	fields := fs.String("fields", "", "comma separated blog fields to show, like title,tags, every field if empty")
+	parse(fs, args)
+	out.check()
+
+	if *blogID == "" {
+		log.Fatalf("Missing -id")
+	}
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	res, err := c.ReadBlog(context.Background(), &blogpb.ReadBlogRequest{
+		BlogId:   *blogID,
+		ReadMask: parseFields(*fields),
+	})
+	if err != nil {
+		log.Fatalf("error while calling ReadBlog RPC: %v", err)
+	}
+	out.printBlogs(res.GetBlog())
+}
+
+func doUpdate(args []string) {
+	fs := flag.NewFlagSet("update", flag.ExitOnError)
+	var conn connFlags
+	var idem idempotency
+	var out output
+	conn.register(fs)
+	idem.register(fs)
+	out.register(fs)
+	blogID := fs.String("id", "", "id of the blog (required)")
+	authorID := fs.String("author", "", "new author of the blog")
+	title := fs.String("title", "", "new title of the blog")
+	content := fs.String("content", "", "new content of the blog")
+	contentFile := fs.String("content-file", "", "read the new content from this file, - for stdin")
+	format := fs.String("format", "", "new format of the content: plain, markdown or html")
+	tags := fs.String("tags", "", "new comma separated tags of the blog")
+	editorID := fs.String("editor", "", "who makes this change, the author if empty")
+	version := fs.Int64("version", 0, "fail if the blog is not at this version anymore, not checked if 0")
+	// only the flags given on the command line change the blog, not the
+	// ones set from the environment or a config file shared with create
+	set := parse(fs, args)
+	out.check()
+
+	if *blogID == "" {
+		log.Fatalf("Missing -id")
+	}
+
+	blog := &blogpb.Blog{
+		Id:      *blogID,
+		Version: *version,
+	}
+	mask := &field_mask.FieldMask{}
+	if set["author"] {
+		blog.AuthorId = *authorID
+		mask.Paths = append(mask.Paths, "author_id")
+	}
+	if set["title"] {
+		blog.Title = *title
+		mask.Paths = append(mask.Paths, "title")
+	}
+	if set["content"] || set["content-file"] {
+		blog.Content = readContent(*content, *contentFile)
+		mask.Paths = append(mask.Paths, "content")
+	}
+	if set["format"] {
+		blog.ContentFormat = parseFormat(*format)
+		mask.Paths = append(mask.Paths, "content_format")
+	}
+	if set["tags"] {
+		blog.Tags = splitTags(*tags)
+		mask.Paths = append(mask.Paths, "tags")
+	}
+	if len(mask.GetPaths()) == 0 {
+		log.Fatalf("Nothing to update, give at least one of -author, -title, -content, -content-file, -format or -tags")
+	}
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	ctx, header := idem.call()
+	res, err := c.UpdateBlog(ctx, &blogpb.UpdateBlogRequest{
+		Blog:       blog,
+		EditorId:   *editorID,
+		UpdateMask: mask,
+	}, header)
+	if err != nil {
+		printViolations(err)
+		log.Fatalf("error while calling UpdateBlog RPC: %v", err)
+	}
+	idem.report()
+	out.printBlogs(res.GetBlog())
+}
+
+func doRender(args []string) {
+	fs := flag.NewFlagSet("render", flag.ExitOnError)
+	var conn connFlags
+	conn.register(fs)
+	blogID := fs.String("id", "", "id of the blog (required)")
+	showExcerpt := fs.Bool("excerpt", false, "print the plain text excerpt instead of the HTML")
+	parse(fs, args)
+
+	if *blogID == "" {
+		log.Fatalf("Missing -id")
+	}
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	res, err := c.RenderBlog(context.Background(), &blogpb.RenderBlogRequest{
+		BlogId: *blogID,
+	})
+	if err != nil {
+		log.Fatalf("error while calling RenderBlog RPC: %v", err)
+	}
+	if *showExcerpt {
+		fmt.Println(res.GetExcerpt())
+		return
+	}
+	fmt.Print(res.GetHtml())
+}
+
+// parseStatuses returns the blog statuses of a comma separated list
+func parseStatuses(list string) []blogpb.Blog_Status {
+	var statuses []blogpb.Blog_Status
+	if list == "" {
+		return statuses
+	}
+	for _, name := range strings.Split(list, ",") {
+		st, ok := blogpb.Blog_Status_value[strings.ToUpper(strings.TrimSpace(name))]
+		if !ok {
+			log.Fatalf("Unknown blog status: %v", name)
+		}
+		statuses = append(statuses, blogpb.Blog_Status(st))
+	}
+	return statuses
+}
+
+// printTagCounts writes a table of tags and how many blogs use them
+func printTagCounts(counts []*blogpb.TagCount) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "TAG\tBLOGS")
+	for _, tc := range counts {
+		fmt.Fprintf(w, "%v\t%v\n", tc.GetTag(), tc.GetCount())
+	}
+	w.Flush()
+}
+
+func doTags(args []string) {
+	fs := flag.NewFlagSet("tags", flag.ExitOnError)
+	var conn connFlags
+	conn.register(fs)
+	statusNames := fs.String("status", "", "comma separated statuses of the blogs to count, only published blogs if empty")
+	parse(fs, args)
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	res, err := c.ListTags(context.Background(), &blogpb.ListTagsRequest{
+		Statuses: parseStatuses(*statusNames),
+	})
+	if err != nil {
+		log.Fatalf("error while calling ListTags RPC: %v", err)
+	}
+	printTagCounts(res.GetTags())
+}
+
+func doPublish(args []string) {
+	fs := flag.NewFlagSet("publish", flag.ExitOnError)
+	var conn connFlags
+	var idem idempotency
+	var out output
+	conn.register(fs)
+	idem.register(fs)
+	out.register(fs)
+	blogID := fs.String("id", "", "id of the blog (required)")
+	at := fs.String("at", "", "publish at this RFC 3339 time, e.g. 2020-01-02T15:04:05Z, right away if empty")
+	editorID := fs.String("editor", "", "who publishes the blog, the author if empty")
+	version := fs.Int64("version", 0, "fail if the blog is not at this version anymore, not checked if 0")
+	parse(fs, args)
+	out.check()
+
+	if *blogID == "" {
+		log.Fatalf("Missing -id")
+	}
+
+	req := &blogpb.PublishBlogRequest{
+		BlogId:   *blogID,
+		Version:  *version,
+		EditorId: *editorID,
+	}
+	if *at != "" {
+		t, err := time.Parse(time.RFC3339, *at)
+		if err != nil {
+			log.Fatalf("Invalid -at: %v", err)
+		}
+		req.PublishAt, err = ptypes.TimestampProto(t)
+		if err != nil {
+			log.Fatalf("Invalid -at: %v", err)
+		}
+	}
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	ctx, header := idem.call()
+	res, err := c.PublishBlog(ctx, req, header)
+	if err != nil {
+		log.Fatalf("error while calling PublishBlog RPC: %v", err)
+	}
+	idem.report()
+	out.printBlogs(res.GetBlog())
+}
+
+func doDelete(args []string) {
+	fs := flag.NewFlagSet("delete", flag.ExitOnError)
+	var conn connFlags
+	var idem idempotency
+	conn.register(fs)
+	idem.register(fs)
+	blogID := fs.String("id", "", "id of the blog (required)")
+	version := fs.Int64("version", 0, "fail if the blog is not at this version anymore, not checked if 0")
+	parse(fs, args)
+
+	if *blogID == "" {
+		log.Fatalf("Missing -id")
+	}
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	ctx, header := idem.call()
+	res, err := c.DeleteBlog(ctx, &blogpb.DeleteBlogRequest{
+		BlogId:  *blogID,
+		Version: *version,
+	}, header)
+	if err != nil {
+		log.Fatalf("error while calling DeleteBlog RPC: %v", err)
+	}
+	idem.report()
+	fmt.Printf("Moved blog %v to the trash\n", res.GetBlogId())
+}
+
+func doRestore(args []string) {
+	fs := flag.NewFlagSet("restore", flag.ExitOnError)
+	var conn connFlags
+	var idem idempotency
+	var out output
+	conn.register(fs)
+	idem.register(fs)
+	out.register(fs)
+	blogID := fs.String("id", "", "id of the blog (required)")
+	parse(fs, args)
+	out.check()
+
+	if *blogID == "" {
+		log.Fatalf("Missing -id")
+	}
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	ctx, header := idem.call()
+	res, err := c.RestoreBlog(ctx, &blogpb.RestoreBlogRequest{
+		BlogId: *blogID,
+	}, header)
+	if err != nil {
+		log.Fatalf("error while calling RestoreBlog RPC: %v", err)
+	}
+	idem.report()
+	out.printBlogs(res.GetBlog())
+}
+
+func doTrash(args []string) {
+	fs := flag.NewFlagSet("trash", flag.ExitOnError)
+	var conn connFlags
+	var out output
+	conn.register(fs)
+	out.register(fs)
+	authorID := fs.String("author", "", "only list the deleted blogs of this author")
+	parse(fs, args)
+	out.check()
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	res, err := c.ListTrash(context.Background(), &blogpb.ListTrashRequest{
+		AuthorId: *authorID,
+	})
+	if err != nil {
+		log.Fatalf("error while calling ListTrash RPC: %v", err)
+	}
+	out.printBlogs(res.GetBlogs()...)
+}
+
+func doList(args []string) {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	var conn connFlags
+	var out output
+	conn.register(fs)
+	out.register(fs)
+	authorID := fs.String("author", "", "only list the blogs of this author")
+	pageSize := fs.Int("page-size", 0, "number of blogs per page, the server default if 0")
+	cursor := fs.String("cursor", "", "cursor printed by a previous list to get the next page")
+	statusNames := fs.String("status", "", "comma separated statuses to list, only published blogs if empty")
+	tags := fs.String("tags", "", "comma separated tags, only list blogs with any of them")
+	allTags := fs.Bool("all-tags", false, "only list blogs with all the tags given with -tags")
+	fields := fs.String("fields", "", "comma separated blog fields to show, like id,title, every field if empty")
+	parse(fs, args)
+	out.check()
+
+	statuses := parseStatuses(*statusNames)
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	var trailer metadata.MD
+	stream, err := c.ListBlog(context.Background(), &blogpb.ListBlogRequest{
+		AuthorId:     *authorID,
+		PageSize:     int32(*pageSize),
+		Cursor:       *cursor,
+		Statuses:     statuses,
+		Tags:         splitTags(*tags),
+		MatchAllTags: *allTags,
+		ReadMask:     parseFields(*fields),
+	}, grpc.Trailer(&trailer))
+	if err != nil {
+		log.Fatalf("error while calling ListBlog RPC: %v", err)
+	}
+
+	var blogs []*blogpb.Blog
+	var facets []*blogpb.TagCount
+	lastCursor := *cursor
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			// we've reached the end of the stream
+			break
+		}
+		if err != nil {
+			if len(blogs) > 0 {
+				out.printBlogs(blogs...)
+			}
+			log.Fatalf("error while reading stream, resume with -cursor %v: %v", lastCursor, err)
+		}
+		blogs = append(blogs, res.GetBlog())
+		if res.GetFacets() != nil {
+			facets = res.GetFacets()
+		}
+		lastCursor = res.GetCursor()
+	}
+
+	out.printBlogs(blogs...)
+	if len(facets) > 0 && out.format == "table" {
+		fmt.Println()
+		printTagCounts(facets)
+	}
+	if next := trailer.Get("next-cursor"); len(next) > 0 && next[0] != "" {
+		fmt.Fprintf(os.Stderr, "More blogs available, get them with -cursor %v\n", next[0])
+	}
+}
+
+func doCreateAuthor(args []string) {
+	fs := flag.NewFlagSet("create-author", flag.ExitOnError)
+	var conn connFlags
+	var idem idempotency
+	conn.register(fs)
+	idem.register(fs)
+	authorID := fs.String("id", "", "id of the author, a random id is assigned if empty")
+	name := fs.String("name", "", "name of the author (required)")
+	bio := fs.String("bio", "", "short biography of the author")
+	parse(fs, args)
+
+	if *name == "" {
+		log.Fatalf("Missing -name")
+	}
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	ctx, header := idem.call()
+	res, err := c.CreateAuthor(ctx, &blogpb.CreateAuthorRequest{
+		Author: &blogpb.Author{
+			Id:   *authorID,
+			Name: *name,
+			Bio:  *bio,
+		},
+	}, header)
+	if err != nil {
+		log.Fatalf("error while calling CreateAuthor RPC: %v", err)
+	}
+	idem.report()
+	fmt.Printf("Created author %v (%v)\n", res.GetAuthor().GetId(), res.GetAuthor().GetName())
+}
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"github.com/golang/protobuf/jsonpb"
+	"grpc-go-course/blog/blogpb"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// uploadChunkSize is the size of the chunks sent by the upload command
+const uploadChunkSize = 64 * 1024
+
+func doImport(args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	var conn connFlags
+	conn.register(fs)
+	file := fs.String("file", "", "JSON lines file with one blog per line, stdin if empty")
+	parse(fs, args)
+
+	in := os.Stdin
+	if *file != "" {
+		f, err := os.Open(*file)
+		if err != nil {
+			log.Fatalf("Cannot open %v: %v", *file, err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	stream, err := c.ImportBlogs(context.Background())
+	if err != nil {
+		log.Fatalf("error while calling ImportBlogs RPC: %v", err)
+	}
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	sent := 0
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		blog := &blogpb.Blog{}
+		if err := jsonpb.UnmarshalString(text, blog); err != nil {
+			log.Fatalf("Invalid blog on line %v: %v", line, err)
+		}
+		if err := stream.Send(&blogpb.ImportBlogsRequest{Blog: blog}); err != nil {
+			log.Fatalf("error while sending blog to server: %v", err)
+		}
+		sent++
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatalf("error while reading blogs: %v", err)
+	}
+
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Fatalf("error while receiving response from ImportBlogs: %v", err)
+	}
+
+	fmt.Printf("Sent %v blogs: %v imported, %v failed\n", sent, res.GetImported(), res.GetFailed())
+	for _, failure := range res.GetFailures() {
+		fmt.Printf("  blog #%v %v: %v\n", failure.GetIndex(), failure.GetBlogId(), failure.GetError())
+	}
+	if res.GetFailed() > 0 {
+		os.Exit(1)
+	}
+}
+
+func doExport(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	var conn connFlags
+	conn.register(fs)
+	file := fs.String("file", "", "JSON lines file to write, stdout if empty")
+	authorID := fs.String("author", "", "only export the blogs of this author")
+	parse(fs, args)
+
+	out := os.Stdout
+	if *file != "" {
+		f, err := os.Create(*file)
+		if err != nil {
+			log.Fatalf("Cannot create %v: %v", *file, err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	stream, err := c.ExportBlogs(context.Background(), &blogpb.ExportBlogsRequest{
+		AuthorId: *authorID,
+	})
+	if err != nil {
+		log.Fatalf("error while calling ExportBlogs RPC: %v", err)
+	}
+
+	w := bufio.NewWriter(out)
+	m := jsonpb.Marshaler{OrigName: true}
+	count := 0
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			// we've reached the end of the stream
+			break
+		}
+		if err != nil {
+			log.Fatalf("error while reading stream: %v", err)
+		}
+		if err := m.Marshal(w, res.GetBlog()); err != nil {
+			log.Fatalf("error while writing blog: %v", err)
+		}
+		w.WriteString("\n")
+		count++
+	}
+	if err := w.Flush(); err != nil {
+		log.Fatalf("error while writing blogs: %v", err)
+	}
+
+	if *file != "" {
+		fmt.Printf("Exported %v blogs to %v\n", count, *file)
+	}
+}
+
+func doUpload(args []string) {
+	fs := flag.NewFlagSet("upload", flag.ExitOnError)
+	var conn connFlags
+	conn.register(fs)
+	blogID := fs.String("blog", "", "id of the blog the file is attached to (required)")
+	file := fs.String("file", "", "file to upload (required)")
+	contentType := fs.String("content-type", "", "content type of the file, guessed from its name if empty")
+	parse(fs, args)
+
+	if *blogID == "" || *file == "" {
+		log.Fatalf("Missing -blog or -file")
+	}
+	if *contentType == "" {
+		*contentType = mime.TypeByExtension(filepath.Ext(*file))
+	}
+	if *contentType == "" {
+		*contentType = "application/octet-stream"
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		log.Fatalf("Cannot open %v: %v", *file, err)
+	}
+	defer f.Close()
+
+	// the checksum goes in the first message, so the file is read twice
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		log.Fatalf("Cannot read %v: %v", *file, err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		log.Fatalf("Cannot read %v: %v", *file, err)
+	}
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	stream, err := c.UploadAttachment(context.Background())
+	if err != nil {
+		log.Fatalf("error while calling UploadAttachment RPC: %v", err)
+	}
+
+	err = stream.Send(&blogpb.UploadAttachmentRequest{
+		Data: &blogpb.UploadAttachmentRequest_Info{
+			Info: &blogpb.Attachment{
+				BlogId:      *blogID,
+				Filename:    filepath.Base(*file),
+				ContentType: *contentType,
+				Sha256:      hex.EncodeToString(h.Sum(nil)),
+			},
+		},
+	})
+	buf := make([]byte, uploadChunkSize)
+	for err == nil {
+		n, readErr := f.Read(buf)
+		if n > 0 {
+			err = stream.Send(&blogpb.UploadAttachmentRequest{
+				Data: &blogpb.UploadAttachmentRequest_Chunk{Chunk: buf[:n]},
+			})
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			log.Fatalf("Cannot read %v: %v", *file, readErr)
+		}
+	}
+	// when the server gives up early, Send returns io.EOF and the reason
+	// comes with the response
+	if err != nil && err != io.EOF {
+		log.Fatalf("error while sending file to server: %v", err)
+	}
+
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Fatalf("error while receiving response from UploadAttachment: %v", err)
+	}
+
+	attachment := res.GetAttachment()
+	fmt.Printf("Uploaded %v (%v bytes) as attachment %v\n",
+		attachment.GetFilename(), attachment.GetSize(), attachment.GetId())
+}
+
+func doDownload(args []string) {
+	fs := flag.NewFlagSet("download", flag.ExitOnError)
+	var conn connFlags
+	conn.register(fs)
+	attachmentID := fs.String("id", "", "id of the attachment (required)")
+	file := fs.String("out", "", "file to write, the name of the attachment if empty")
+	parse(fs, args)
+
+	if *attachmentID == "" {
+		log.Fatalf("Missing -id")
+	}
+
+	cc, c := conn.dial()
+	defer cc.Close()
+
+	stream, err := c.DownloadAttachment(context.Background(), &blogpb.DownloadAttachmentRequest{
+		AttachmentId: *attachmentID,
+	})
+	if err != nil {
+		log.Fatalf("error while calling DownloadAttachment RPC: %v", err)
+	}
+
+	res, err := stream.Recv()
+	if err != nil {
+		log.Fatalf("error while reading stream: %v", err)
+	}
+	info := res.GetInfo()
+	if info == nil {
+		log.Fatalf("The server did not send the attachment info first")
+	}
+	if *file == "" {
+		*file = filepath.Base(info.GetFilename())
+	}
+
+	out, err := os.Create(*file)
+	if err != nil {
+		log.Fatalf("Cannot create %v: %v", *file, err)
+	}
+	defer out.Close()
+
+	h := sha256.New()
+	w := io.MultiWriter(out, h)
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			// we've reached the end of the stream
+			break
+		}
+		if err != nil {
+			log.Fatalf("error while reading stream: %v", err)
+		}
+		if _, err := w.Write(res.GetChunk()); err != nil {
+			log.Fatalf("error while writing %v: %v", *file, err)
+		}
+	}
+	if err := out.Close(); err != nil {
+		log.Fatalf("error while writing %v: %v", *file, err)
+	}
+
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != info.GetSha256() {
+		log.Fatalf("The content of %v does not match its SHA-256, download it again", *file)
+	}
+	fmt.Printf("Downloaded %v (%v, %v bytes) to %v\n",
+		info.GetFilename(), info.GetContentType(), info.GetSize(), *file)
+}
@@ -1,53 +1,81 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"google.golang.org/grpc"
-	"grpc-go-course/blog/blogpb"
+	"grpc-go-course/blog/blogservice"
+	"grpc-go-course/config"
+	"grpc-go-course/runner"
 	"log"
-	"net"
 	"os"
-	"os/signal"
+	"time"
 )
 
-type server struct {
-
-}
-
 func main() {
 	//if we crash the go code, we get the file name and line number
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 
+	blogCfg := blogservice.DefaultConfig()
+	blogCfg.Register(flag.CommandLine)
+	cfg := config.Server{
+		Listen:       "0.0.0.0:50051",
+		CertFile:     "ssl/server.crt",
+		KeyFile:      "ssl/server.pem",
+		DrainDelay:   5 * time.Second,
+		DrainTimeout: 15 * time.Second,
+		LogLevel:     "warning",
+	}
+	cfg.Register(flag.CommandLine)
+	if err := config.Load(flag.CommandLine, os.Args[1:], "BLOG_SERVER_"); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if err := config.SetupLogging(cfg.LogLevel); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
 	fmt.Println("Blog Service Started")
 
-	lis, err := net.Listen("tcp", "0.0.0.0:50051")
+	blogService, err := blogservice.Open(blogCfg)
+	if err != nil {
+		log.Fatalf("Failed to start the blog service: %v", err)
+	}
+
+	lis, err := cfg.NewListener()
 	if err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
 
-	opts := []grpc.ServerOption{}
+	opts, sslErr := cfg.ServerOptions()
+	if sslErr != nil {
+		log.Fatalf("Failed loading certificates : %v", sslErr)
+	}
+	r := runner.New(cfg.DrainDelay, cfg.DrainTimeout)
+	opts = append(opts,
+		grpc.UnaryInterceptor(runner.ChainUnary(
+			r.UnaryServerInterceptor,
+			blogService.UnaryServerInterceptor,
+		)),
+		grpc.StreamInterceptor(runner.ChainStream(
+			r.StreamServerInterceptor,
+			blogService.StreamServerInterceptor,
+		)),
+	)
 
 	s := grpc.NewServer(opts...)
-	blogpb.RegisterBlogServiceServer(s, &server{})
-
-	go func() {
-
-		fmt.Println("Starting server....")
-		if err := s.Serve(lis); err != nil {
-			log.Fatalf("Failed to serve: %v", err)
-		}
-	}()
-
-	//Wait for CTRL+c to exit
-	ch := make(chan os.Signal, 1)
-	signal.Notify(ch, os.Interrupt)
-
-	//Block until a signal is received
-	<-ch
-	fmt.Println("Stopping the server")
-	s.Stop()
-	fmt.Println("Closing the listener")
-	lis.Close()
+	blogService.Register(s)
+	hs := r.RegisterHealth(s)
+	cfg.RegisterReflection(s)
+
+	blogService.ReportHealth(hs)
+	blogService.Start()
+	r.OnDrain(blogService.Drain)
+
+	if err := r.Run(s, lis); err != nil {
+		log.Fatalf("Failed to serve: %v", err)
+	}
+	if err := blogService.Close(); err != nil {
+		log.Printf("Failed to close the blog storage: %v", err)
+	}
 	fmt.Println("End of program")
 }
-
@@ -0,0 +1,250 @@
+package blogattach
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"grpc-go-course/blog/blogpb"
+)
+
+// ErrNotFound is returned when no attachment exists with the requested id
+var ErrNotFound = errors.New("attachment not found")
+
+// ErrTooLarge is returned when an upload goes over the size limit
+var ErrTooLarge = errors.New("attachment is too large")
+
+// ErrChecksumMismatch is returned when the content of an upload does not
+// match the checksum announced by the client
+var ErrChecksumMismatch = errors.New("attachment does not match its checksum")
+
+const (
+	contentSuffix = ".data"
+	infoSuffix    = ".json"
+	uploadPrefix  = ".upload-"
+)
+
+// Store keeps attachments as files in a directory. Each attachment is made
+// of a content file and a JSON file describing it, both named after its id.
+type Store struct {
+	dir     string
+	maxSize int64
+}
+
+// Open returns a store keeping its files in dir, which is created if needed.
+// Uploads bigger than maxSize bytes are refused. The files of uploads cut
+// short by an earlier run are removed.
+func Open(dir string, maxSize int64) (*Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	uploads, err := filepath.Glob(filepath.Join(dir, uploadPrefix+"*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range uploads {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	return &Store{
+		dir:     dir,
+		maxSize: maxSize,
+	}, nil
+}
+
+// Upload is an attachment being received. Nothing is visible in the store
+// until the upload is committed.
+type Upload struct {
+	store *Store
+	info  *blogpb.Attachment
+	file  *os.File
+	hash  hash.Hash
+	size  int64
+}
+
+// Create starts the upload of an attachment described by info.
+// The id, size and creation time of info are ignored.
+func (s *Store) Create(info *blogpb.Attachment) (*Upload, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Create(filepath.Join(s.dir, uploadPrefix+id))
+	if err != nil {
+		return nil, err
+	}
+	return &Upload{
+		store: s,
+		info: &blogpb.Attachment{
+			Id:          id,
+			BlogId:      info.GetBlogId(),
+			Filename:    filepath.Base(info.GetFilename()),
+			ContentType: info.GetContentType(),
+			Sha256:      strings.ToLower(info.GetSha256()),
+		},
+		file: file,
+		hash: sha256.New(),
+	}, nil
+}
+
+// Write appends a chunk to the content of the upload
+func (u *Upload) Write(p []byte) (int, error) {
+	if u.size+int64(len(p)) > u.store.maxSize {
+		return 0, ErrTooLarge
+	}
+	n, err := u.file.Write(p)
+	u.hash.Write(p[:n])
+	u.size += int64(n)
+	return n, err
+}
+
+// Commit checks the content of the upload against its checksum and makes
+// the attachment available
+func (u *Upload) Commit() (*blogpb.Attachment, error) {
+	sum := hex.EncodeToString(u.hash.Sum(nil))
+	if sum != u.info.GetSha256() {
+		u.Abort()
+		return nil, ErrChecksumMismatch
+	}
+	u.info.Size = u.size
+	u.info.CreatedAt = ptypes.TimestampNow()
+
+	if err := u.file.Sync(); err != nil {
+		u.Abort()
+		return nil, err
+	}
+	if err := u.file.Close(); err != nil {
+		u.Abort()
+		return nil, err
+	}
+
+	m := jsonpb.Marshaler{OrigName: true}
+	data, err := m.MarshalToString(u.info)
+	if err != nil {
+		u.Abort()
+		return nil, err
+	}
+	infoTmp := filepath.Join(u.store.dir, uploadPrefix+u.info.GetId()+infoSuffix)
+	if err := ioutil.WriteFile(infoTmp, []byte(data), 0644); err != nil {
+		u.Abort()
+		return nil, err
+	}
+
+	// the content goes first so an attachment never has a description
+	// without content
+	if err := os.Rename(u.file.Name(), u.store.contentPath(u.info.GetId())); err != nil {
+		os.Remove(infoTmp)
+		u.Abort()
+		return nil, err
+	}
+	if err := os.Rename(infoTmp, u.store.infoPath(u.info.GetId())); err != nil {
+		os.Remove(infoTmp)
+		os.Remove(u.store.contentPath(u.info.GetId()))
+		return nil, err
+	}
+	return u.info, nil
+}
+
+// Abort drops the upload
+func (u *Upload) Abort() {
+	u.file.Close()
+	os.Remove(u.file.Name())
+}
+
+// Get returns the description of an attachment and its content, which the
+// caller must close
+func (s *Store) Get(id string) (*blogpb.Attachment, io.ReadCloser, error) {
+	if !validID(id) {
+		return nil, nil, ErrNotFound
+	}
+	data, err := ioutil.ReadFile(s.infoPath(id))
+	if os.IsNotExist(err) {
+		return nil, nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	info := &blogpb.Attachment{}
+	if err := jsonpb.UnmarshalString(string(data), info); err != nil {
+		return nil, nil, err
+	}
+	content, err := os.Open(s.contentPath(id))
+	if err != nil {
+		return nil, nil, err
+	}
+	return info, content, nil
+}
+
+// DeleteBlog removes every attachment of the blog blogID
+func (s *Store) DeleteBlog(blogID string) error {
+	paths, err := filepath.Glob(filepath.Join(s.dir, "*"+infoSuffix))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		id := strings.TrimSuffix(filepath.Base(path), infoSuffix)
+		if !validID(id) {
+			continue
+		}
+		data, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		info := &blogpb.Attachment{}
+		if err := jsonpb.UnmarshalString(string(data), info); err != nil {
+			return err
+		}
+		if info.GetBlogId() != blogID {
+			continue
+		}
+		// the description goes first so an attachment never has a
+		// description without content
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err := os.Remove(s.contentPath(id)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Store) contentPath(id string) string {
+	return filepath.Join(s.dir, id+contentSuffix)
+}
+
+func (s *Store) infoPath(id string) string {
+	return filepath.Join(s.dir, id+infoSuffix)
+}
+
+// newID returns a random hex string used as the id of a new attachment
+func newID() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// validID reports whether id could have been returned by newID, so ids
+// sent by clients can't point outside of the store directory
+func validID(id string) bool {
+	if len(id) != 24 {
+		return false
+	}
+	_, err := hex.DecodeString(id)
+	return err == nil
+}
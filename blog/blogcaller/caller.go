@@ -0,0 +1,29 @@
+package blogcaller
+
+import (
+	"net"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// ID identifies the client making a call: the common name of its
+// certificate when it authenticated with TLS, its IP address otherwise.
+// Unlike the ids sent in requests, callers cannot choose it.
+func ID(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+		if certs := tlsInfo.State.PeerCertificates; len(certs) > 0 {
+			return "cn:" + certs[0].Subject.CommonName
+		}
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
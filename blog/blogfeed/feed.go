@@ -0,0 +1,168 @@
+package blogfeed
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"grpc-go-course/blog/blogpb"
+)
+
+const (
+	// historySize is how many past events are kept for resuming watchers
+	historySize = 1000
+
+	// bufferSize is how many events a watcher can lag behind before it
+	// is dropped
+	bufferSize = 100
+)
+
+// ErrSequenceExpired is returned when asked to resume from an event that
+// is not in the history anymore, or that was published before the server
+// restarted
+var ErrSequenceExpired = errors.New("sequence is too old to be resumed")
+
+// ErrClosed is returned when subscribing to a closed feed
+var ErrClosed = errors.New("feed is closed")
+
+// ErrSequenceUnknown is returned when asked to resume from an event that
+// has not happened yet
+var ErrSequenceUnknown = errors.New("sequence has not been reached")
+
+// Feed numbers the changes made to blogs and hands them to watchers.
+// It keeps a bounded history so watchers can resume after a reconnection.
+// It is safe for concurrent use.
+type Feed struct {
+	mu       sync.Mutex
+	sequence int64
+	history  []*blogpb.BlogEvent
+	watchers map[*Watcher]bool
+	closed   bool
+}
+
+// Watcher receives the events published after it subscribed
+type Watcher struct {
+	feed *Feed
+	ch   chan *blogpb.BlogEvent
+	// lagging is set when the watcher was dropped because it was too slow
+	lagging bool
+}
+
+// NewFeed returns a feed without any event. Its sequence numbers start
+// from the current time in microseconds, so the events of an earlier run
+// of the server have smaller sequences than any event of this one and a
+// watcher resuming from them is told they expired.
+func NewFeed() *Feed {
+	return newFeed(time.Now().UnixNano() / int64(time.Microsecond))
+}
+
+// newFeed returns a feed whose first event has the sequence start+1
+func newFeed(start int64) *Feed {
+	return &Feed{
+		sequence: start,
+		watchers: make(map[*Watcher]bool),
+	}
+}
+
+// Publish records a change to a blog and sends it to every watcher.
+// Watchers that can't keep up are dropped.
+func (f *Feed) Publish(eventType blogpb.BlogEvent_Type, blog *blogpb.Blog) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sequence++
+	event := &blogpb.BlogEvent{
+		Sequence:   f.sequence,
+		Type:       eventType,
+		BlogId:     blog.GetId(),
+		Blog:       proto.Clone(blog).(*blogpb.Blog),
+		OccurredAt: ptypes.TimestampNow(),
+	}
+
+	f.history = append(f.history, event)
+	if len(f.history) > historySize {
+		f.history = f.history[len(f.history)-historySize:]
+	}
+
+	for w := range f.watchers {
+		select {
+		case w.ch <- event:
+		default:
+			w.lagging = true
+			f.remove(w)
+		}
+	}
+}
+
+// Subscribe returns the events that happened after fromSequence and a
+// watcher receiving every following event. No event is missed nor
+// repeated between the two. Only new events are watched if fromSequence is 0.
+func (f *Feed) Subscribe(fromSequence int64) ([]*blogpb.BlogEvent, *Watcher, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		return nil, nil, ErrClosed
+	}
+
+	var backlog []*blogpb.BlogEvent
+	if fromSequence > 0 {
+		if fromSequence > f.sequence {
+			return nil, nil, ErrSequenceUnknown
+		}
+		oldest := f.sequence - int64(len(f.history)) + 1
+		if fromSequence < oldest-1 {
+			return nil, nil, ErrSequenceExpired
+		}
+		backlog = append(backlog, f.history[fromSequence-oldest+1:]...)
+	}
+
+	w := &Watcher{
+		feed: f,
+		ch:   make(chan *blogpb.BlogEvent, bufferSize),
+	}
+	f.watchers[w] = true
+	return backlog, w, nil
+}
+
+// Close drops every watcher and refuses new ones. Events can still be
+// published, to be resumed from once the server is back.
+func (f *Feed) Close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	for w := range f.watchers {
+		f.remove(w)
+	}
+}
+
+// remove drops a watcher and closes its channel.
+// Callers must hold the lock.
+func (f *Feed) remove(w *Watcher) {
+	if !f.watchers[w] {
+		return
+	}
+	delete(f.watchers, w)
+	close(w.ch)
+}
+
+// Events returns the channel the events are sent to.
+// It is closed when the watcher is closed or dropped.
+func (w *Watcher) Events() <-chan *blogpb.BlogEvent {
+	return w.ch
+}
+
+// Lagging reports whether the watcher was dropped for being too slow
+func (w *Watcher) Lagging() bool {
+	w.feed.mu.Lock()
+	defer w.feed.mu.Unlock()
+	return w.lagging
+}
+
+// Close stops the watcher
+func (w *Watcher) Close() {
+	w.feed.mu.Lock()
+	defer w.feed.mu.Unlock()
+	w.feed.remove(w)
+}
@@ -0,0 +1,150 @@
+package blogfeed
+
+import (
+	"testing"
+	"time"
+
+	"grpc-go-course/blog/blogpb"
+)
+
+// publish publishes n updates of a blog on f
+func publish(f *Feed, n int) {
+	for i := 0; i < n; i++ {
+		f.Publish(blogpb.BlogEvent_UPDATED, &blogpb.Blog{Id: "blog"})
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	const start = 1000
+
+	tests := []struct {
+		name      string
+		published int
+		from      int64
+		// wantFirst and wantCount describe the backlog
+		wantFirst int64
+		wantCount int
+		wantErr   error
+	}{
+		{
+			name:      "only new events",
+			published: 5,
+			from:      0,
+		},
+		{
+			name:      "every event",
+			published: 5,
+			from:      start,
+			wantFirst: start + 1,
+			wantCount: 5,
+		},
+		{
+			name:      "after an event",
+			published: 5,
+			from:      start + 2,
+			wantFirst: start + 3,
+			wantCount: 3,
+		},
+		{
+			name:      "after the last event",
+			published: 5,
+			from:      start + 5,
+		},
+		{
+			name:      "nothing published yet",
+			published: 0,
+			from:      start,
+		},
+		{
+			name:      "event not published yet",
+			published: 5,
+			from:      start + 6,
+			wantErr:   ErrSequenceUnknown,
+		},
+		{
+			name:      "event of an earlier run",
+			published: 5,
+			from:      start - 1,
+			wantErr:   ErrSequenceExpired,
+		},
+		{
+			name:      "oldest event kept",
+			published: historySize + 10,
+			from:      start + 10,
+			wantFirst: start + 11,
+			wantCount: historySize,
+		},
+		{
+			name:      "event dropped from the history",
+			published: historySize + 10,
+			from:      start + 9,
+			wantErr:   ErrSequenceExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFeed(start)
+			publish(f, tt.published)
+
+			backlog, w, err := f.Subscribe(tt.from)
+			if err != tt.wantErr {
+				t.Fatalf("Subscribe(%v) error = %v, want %v", tt.from, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer w.Close()
+
+			if len(backlog) != tt.wantCount {
+				t.Fatalf("backlog has %v events, want %v", len(backlog), tt.wantCount)
+			}
+			for i, event := range backlog {
+				if want := tt.wantFirst + int64(i); event.GetSequence() != want {
+					t.Fatalf("backlog[%v] has sequence %v, want %v", i, event.GetSequence(), want)
+				}
+			}
+
+			// the next event follows the backlog without gap
+			publish(f, 1)
+			event := <-w.Events()
+			if want := int64(start + tt.published + 1); event.GetSequence() != want {
+				t.Errorf("next event has sequence %v, want %v", event.GetSequence(), want)
+			}
+		})
+	}
+}
+
+func TestNewFeedSequencesOutliveRestarts(t *testing.T) {
+	before := NewFeed()
+	publish(before, 3)
+	_, w, err := before.Subscribe(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publish(before, 1)
+	last := (<-w.Events()).GetSequence()
+
+	// a restart takes far longer than a microsecond per event published
+	time.Sleep(time.Millisecond)
+	after := NewFeed()
+	publish(after, 3)
+	if _, _, err := after.Subscribe(last); err != ErrSequenceExpired {
+		t.Errorf("resuming from sequence %v of the previous run: error = %v, want %v", last, err, ErrSequenceExpired)
+	}
+}
+
+func TestSubscribeClosed(t *testing.T) {
+	f := newFeed(0)
+	_, w, err := f.Subscribe(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	if _, ok := <-w.Events(); ok {
+		t.Error("watcher still open after Close")
+	}
+	if _, _, err := f.Subscribe(0); err != ErrClosed {
+		t.Errorf("Subscribe after Close error = %v, want %v", err, ErrClosed)
+	}
+}
@@ -0,0 +1,184 @@
+package blogidem
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"grpc-go-course/blog/blogcaller"
+)
+
+const (
+	// KeyHeader is the request metadata holding the idempotency key
+	KeyHeader = "idempotency-key"
+
+	// ReplayedHeader is set in the response headers when the response is
+	// the one of an earlier call made with the same key
+	ReplayedHeader = "idempotency-replayed"
+
+	// maxKeyLength is the longest idempotency key accepted
+	maxKeyLength = 200
+)
+
+// Cache remembers the responses of successful calls by idempotency key so
+// retried calls get the original response instead of running again.
+// Failed calls are forgotten and can be retried with the same key.
+type Cache struct {
+	ttl        time.Duration
+	maxEntries int
+	methods    map[string]bool
+
+	mu      sync.Mutex
+	entries map[string]*entry
+	// completed entries, the first to expire first
+	order *list.List
+}
+
+// entry is the state of the calls made with one key
+type entry struct {
+	key    string
+	digest [sha256.Size]byte
+	// closed when the first call is over
+	done chan struct{}
+	// response of the first call, nil if it failed
+	res     proto.Message
+	expires time.Time
+}
+
+// NewCache returns a cache remembering responses for ttl, for at most
+// maxEntries keys. Only calls to the given full method names, like
+// "/blog.BlogService/CreateBlog", are looked at.
+func NewCache(ttl time.Duration, maxEntries int, methods ...string) *Cache {
+	c := &Cache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		methods:    make(map[string]bool),
+		entries:    make(map[string]*entry),
+		order:      list.New(),
+	}
+	for _, m := range methods {
+		c.methods[m] = true
+	}
+	return c
+}
+
+// UnaryServerInterceptor runs the calls carrying an idempotency key at
+// most once per caller and key and replays the response to the retries.
+// Reusing a key with a different request returns FAILED_PRECONDITION.
+func (c *Cache) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !c.methods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(KeyHeader)
+	if len(keys) == 0 || keys[0] == "" {
+		return handler(ctx, req)
+	}
+	if len(keys[0]) > maxKeyLength {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("The idempotency key cannot be longer than %v characters", maxKeyLength),
+		)
+	}
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+	digest, err := digestOf(msg)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			fmt.Sprintf("Internal error: %v", err),
+		)
+	}
+
+	// keys are only unique within a method and for one caller, so a
+	// caller reusing the key of another never sees its response
+	key := info.FullMethod + " " + blogcaller.ID(ctx) + " " + keys[0]
+	for {
+		c.mu.Lock()
+		c.expire(time.Now())
+		e, found := c.entries[key]
+		if !found {
+			e = &entry{
+				key:    key,
+				digest: digest,
+				done:   make(chan struct{}),
+			}
+			c.entries[key] = e
+		}
+		c.mu.Unlock()
+
+		if e.digest != digest {
+			return nil, status.Errorf(
+				codes.FailedPrecondition,
+				fmt.Sprintf("The idempotency key %q was already used with a different request", keys[0]),
+			)
+		}
+		if !found {
+			return c.run(ctx, req, e, handler)
+		}
+
+		// wait for the first call made with the key
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		if e.res != nil {
+			grpc.SetHeader(ctx, metadata.Pairs(ReplayedHeader, "true"))
+			return proto.Clone(e.res), nil
+		}
+		// the first call failed, the key is free again
+	}
+}
+
+// run makes the first call with a key and remembers its response if it
+// succeeds
+func (c *Cache) run(ctx context.Context, req interface{}, e *entry, handler grpc.UnaryHandler) (interface{}, error) {
+	res, err := handler(ctx, req)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if msg, ok := res.(proto.Message); ok && err == nil {
+		e.res = proto.Clone(msg)
+		e.expires = time.Now().Add(c.ttl)
+		c.order.PushBack(e)
+	} else {
+		delete(c.entries, e.key)
+	}
+	close(e.done)
+	return res, err
+}
+
+// expire forgets the responses kept for longer than the TTL, and the
+// oldest ones when there are too many. Callers must hold the lock.
+func (c *Cache) expire(now time.Time) {
+	for front := c.order.Front(); front != nil; front = c.order.Front() {
+		e := front.Value.(*entry)
+		if now.Before(e.expires) && c.order.Len() <= c.maxEntries {
+			return
+		}
+		c.order.Remove(front)
+		delete(c.entries, e.key)
+	}
+}
+
+// digestOf returns a hash of the content of msg, the same for equal
+// messages
+func digestOf(msg proto.Message) ([sha256.Size]byte, error) {
+	var b proto.Buffer
+	b.SetDeterministic(true)
+	if err := b.Marshal(msg); err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(b.Bytes()), nil
+}
@@ -0,0 +1,104 @@
+package blogidem
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"grpc-go-course/blog/blogpb"
+)
+
+const testMethod = "/blog.BlogService/CreateBlog"
+
+// callFrom returns the context of a call made from ip with an idempotency
+// key
+func callFrom(ip string, key string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 40000},
+	})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(KeyHeader, key))
+}
+
+func TestCacheKeysPerCaller(t *testing.T) {
+	type call struct {
+		ip    string
+		title string
+	}
+
+	tests := []struct {
+		name  string
+		calls [2]call
+		// wantRuns is how many times the handler runs
+		wantRuns int
+		// wantCode is the code of the second call
+		wantCode codes.Code
+	}{
+		{
+			name:     "retry of the same caller",
+			calls:    [2]call{{"10.0.0.1", "first"}, {"10.0.0.1", "first"}},
+			wantRuns: 1,
+		},
+		{
+			name:     "key reused by the same caller",
+			calls:    [2]call{{"10.0.0.1", "first"}, {"10.0.0.1", "second"}},
+			wantRuns: 1,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "same request of another caller",
+			calls:    [2]call{{"10.0.0.1", "first"}, {"10.0.0.2", "first"}},
+			wantRuns: 2,
+		},
+		{
+			name:     "other request of another caller",
+			calls:    [2]call{{"10.0.0.1", "first"}, {"10.0.0.2", "second"}},
+			wantRuns: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCache(time.Minute, 10, testMethod)
+			runs := 0
+			// the response names the caller it was made for
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				runs++
+				p, _ := peer.FromContext(ctx)
+				return &blogpb.CreateBlogResponse{
+					Blog: &blogpb.Blog{Id: p.Addr.String(), Title: req.(*blogpb.CreateBlogRequest).GetBlog().GetTitle()},
+				}, nil
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+
+			var res interface{}
+			var err error
+			for _, call := range tt.calls {
+				req := &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{Title: call.title}}
+				res, err = c.UnaryServerInterceptor(callFrom(call.ip, "key"), req, info, handler)
+			}
+
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("second call error = %v, want %v", err, tt.wantCode)
+			}
+			if runs != tt.wantRuns {
+				t.Errorf("handler ran %v times, want %v", runs, tt.wantRuns)
+			}
+			if err != nil {
+				return
+			}
+			// a caller never gets the response made for another
+			second := tt.calls[1]
+			blog := res.(*blogpb.CreateBlogResponse).GetBlog()
+			if host, _, _ := net.SplitHostPort(blog.GetId()); host != second.ip || blog.GetTitle() != second.title {
+				t.Errorf("second call got the response of %v for %q, want the one of %v for %q",
+					blog.GetId(), blog.GetTitle(), second.ip, second.title)
+			}
+		})
+	}
+}
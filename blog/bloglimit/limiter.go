@@ -0,0 +1,136 @@
+package bloglimit
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc-go-course/blog/blogcaller"
+)
+
+// maxIdleBuckets is how many buckets are kept before the full ones, which
+// are the same as no bucket, get dropped
+const maxIdleBuckets = 10000
+
+// Limiter is a token bucket rate limiter for the calls of some methods.
+// Every caller gets its own bucket, refilled at a steady rate up to a
+// burst size, and each call takes a token.
+type Limiter struct {
+	rate    float64
+	burst   float64
+	methods map[string]bool
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a limiter allowing each caller rate calls per second
+// to the given full method names, like "/blog.BlogService/CreateBlog",
+// and up to burst calls at once
+func NewLimiter(rate float64, burst int, methods ...string) *Limiter {
+	l := &Limiter{
+		rate:    rate,
+		burst:   float64(burst),
+		methods: make(map[string]bool),
+		buckets: make(map[string]*bucket),
+	}
+	for _, m := range methods {
+		l.methods[m] = true
+	}
+	return l
+}
+
+// take takes a token from the bucket of key, or returns how long to wait
+// before a token is available
+func (l *Limiter) take(key string, now time.Time) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.buckets) > maxIdleBuckets {
+		l.dropFull(now)
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	l.refill(b, now)
+	if b.tokens < 1 {
+		wait := math.Ceil((1 - b.tokens) / l.rate * float64(time.Second))
+		return time.Duration(wait), false
+	}
+	b.tokens--
+	return 0, true
+}
+
+// refill adds the tokens earned since the last call to b
+func (l *Limiter) refill(b *bucket, now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(l.burst, b.tokens+elapsed*l.rate)
+		b.last = now
+	}
+}
+
+// dropFull forgets the buckets of the callers that stayed idle long enough
+// to refill them. Callers must hold the lock.
+func (l *Limiter) dropFull(now time.Time) {
+	for key, b := range l.buckets {
+		l.refill(b, now)
+		if b.tokens >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// UnaryServerInterceptor rejects the calls of the callers going faster than
+// the rate limit with RESOURCE_EXHAUSTED and a RetryInfo detail. Calls are
+// limited by caller, never by the author the request names, which any
+// caller can choose.
+func (l *Limiter) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !l.methods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	if wait, ok := l.take("caller:"+blogcaller.ID(ctx), time.Now()); !ok {
+		return nil, Error(wait)
+	}
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor rejects the streams opened by the callers going
+// faster than the rate limit, limited by caller
+func (l *Limiter) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !l.methods[info.FullMethod] {
+		return handler(srv, ss)
+	}
+	if wait, ok := l.take("caller:"+blogcaller.ID(ss.Context()), time.Now()); !ok {
+		return Error(wait)
+	}
+	return handler(srv, ss)
+}
+
+// Error returns a RESOURCE_EXHAUSTED status telling to retry after wait
+func Error(wait time.Duration) error {
+	st := status.New(
+		codes.ResourceExhausted,
+		fmt.Sprintf("Too many changes, try again in %v", wait.Round(time.Millisecond)),
+	)
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: ptypes.DurationProto(wait),
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -7,7 +7,11 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	math "math"
 )
 
@@ -22,21 +26,197 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// how the content is written
+type Blog_Format int32
+
+const (
+	Blog_PLAIN    Blog_Format = 0
+	Blog_MARKDOWN Blog_Format = 1
+	Blog_HTML     Blog_Format = 2
+)
+
+var Blog_Format_name = map[int32]string{
+	0: "PLAIN",
+	1: "MARKDOWN",
+	2: "HTML",
+}
+
+var Blog_Format_value = map[string]int32{
+	"PLAIN":    0,
+	"MARKDOWN": 1,
+	"HTML":     2,
+}
+
+func (x Blog_Format) String() string {
+	return proto.EnumName(Blog_Format_name, int32(x))
+}
+
+func (Blog_Format) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{1, 0}
+}
+
+// where the blog is in the editorial workflow
+type Blog_Status int32
+
+const (
+	// new blogs are drafts until they are published
+	Blog_DRAFT Blog_Status = 0
+	// will be published automatically at publish_at
+	Blog_SCHEDULED Blog_Status = 1
+	Blog_PUBLISHED Blog_Status = 2
+	Blog_ARCHIVED  Blog_Status = 3
+)
+
+var Blog_Status_name = map[int32]string{
+	0: "DRAFT",
+	1: "SCHEDULED",
+	2: "PUBLISHED",
+	3: "ARCHIVED",
+}
+
+var Blog_Status_value = map[string]int32{
+	"DRAFT":     0,
+	"SCHEDULED": 1,
+	"PUBLISHED": 2,
+	"ARCHIVED":  3,
+}
+
+func (x Blog_Status) String() string {
+	return proto.EnumName(Blog_Status_name, int32(x))
+}
+
+func (Blog_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{1, 1}
+}
+
+type BlogEvent_Type int32
+
+const (
+	BlogEvent_UNKNOWN BlogEvent_Type = 0
+	BlogEvent_CREATED BlogEvent_Type = 1
+	BlogEvent_UPDATED BlogEvent_Type = 2
+	BlogEvent_DELETED BlogEvent_Type = 3
+	// a deleted blog was taken out of the trash
+	BlogEvent_RESTORED BlogEvent_Type = 4
+)
+
+var BlogEvent_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "CREATED",
+	2: "UPDATED",
+	3: "DELETED",
+	4: "RESTORED",
+}
+
+var BlogEvent_Type_value = map[string]int32{
+	"UNKNOWN":  0,
+	"CREATED":  1,
+	"UPDATED":  2,
+	"DELETED":  3,
+	"RESTORED": 4,
+}
+
+func (x BlogEvent_Type) String() string {
+	return proto.EnumName(BlogEvent_Type_name, int32(x))
+}
+
+func (BlogEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{30, 0}
+}
+
+type Author struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bio                  string               `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Author) Reset()         { *m = Author{} }
+func (m *Author) String() string { return proto.CompactTextString(m) }
+func (*Author) ProtoMessage()    {}
+func (*Author) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{0}
+}
+
+func (m *Author) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Author.Unmarshal(m, b)
+}
+func (m *Author) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Author.Marshal(b, m, deterministic)
+}
+func (m *Author) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Author.Merge(m, src)
+}
+func (m *Author) XXX_Size() int {
+	return xxx_messageInfo_Author.Size(m)
+}
+func (m *Author) XXX_DiscardUnknown() {
+	xxx_messageInfo_Author.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Author proto.InternalMessageInfo
+
+func (m *Author) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Author) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Author) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *Author) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
 type Blog struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AuthorId             string   `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	Title                string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Content              string   `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// must be the id of an existing author
+	AuthorId string `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Title    string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content  string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// incremented by the server on every change, send it back in updates
+	// and deletes to make sure nobody changed the blog in the meantime
+	Version       int64       `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	ContentFormat Blog_Format `protobuf:"varint,6,opt,name=content_format,json=contentFormat,proto3,enum=blog.Blog_Format" json:"content_format,omitempty"`
+	// only changed by PublishBlog and the scheduler, ignored by the other
+	// RPCs changing blogs
+	Status Blog_Status `protobuf:"varint,7,opt,name=status,proto3,enum=blog.Blog_Status" json:"status,omitempty"`
+	// when the blog was or will be published, changed along with status
+	PublishAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// lower case, without duplicates
+	Tags []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	// only set on deleted blogs, which stay in the trash until restored or
+	// purged
+	DeletedAt            *timestamp.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Blog) Reset()         { *m = Blog{} }
 func (m *Blog) String() string { return proto.CompactTextString(m) }
 func (*Blog) ProtoMessage()    {}
 func (*Blog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a4b0406114889fe6, []int{0}
+	return fileDescriptor_a4b0406114889fe6, []int{1}
 }
 
 func (m *Blog) XXX_Unmarshal(b []byte) error {
@@ -85,64 +265,4366 @@ func (m *Blog) GetContent() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Blog)(nil), "blog.Blog")
+func (m *Blog) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("blog/blogpb/blog.proto", fileDescriptor_a4b0406114889fe6) }
+func (m *Blog) GetContentFormat() Blog_Format {
+	if m != nil {
+		return m.ContentFormat
+	}
+	return Blog_PLAIN
+}
 
-var fileDescriptor_a4b0406114889fe6 = []byte{
-	// 148 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xca, 0xc9, 0x4f,
-	0xd7, 0x07, 0x11, 0x05, 0x49, 0x60, 0x4a, 0xaf, 0xa0, 0x28, 0xbf, 0x24, 0x5f, 0x88, 0x05, 0xc4,
-	0x56, 0x4a, 0xe6, 0x62, 0x71, 0xca, 0xc9, 0x4f, 0x17, 0xe2, 0xe3, 0x62, 0xca, 0x4c, 0x91, 0x60,
-	0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x62, 0xca, 0x4c, 0x11, 0x92, 0xe6, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9,
-	0xc8, 0x2f, 0x8a, 0xcf, 0x4c, 0x91, 0x60, 0x02, 0x0b, 0x73, 0x40, 0x04, 0x3c, 0x53, 0x84, 0x44,
-	0xb8, 0x58, 0x4b, 0x32, 0x4b, 0x72, 0x52, 0x25, 0x98, 0xc1, 0x12, 0x10, 0x8e, 0x90, 0x04, 0x17,
-	0x7b, 0x72, 0x7e, 0x5e, 0x49, 0x6a, 0x5e, 0x89, 0x04, 0x0b, 0x58, 0x1c, 0xc6, 0x35, 0xe2, 0xe5,
-	0xe2, 0x06, 0x59, 0x12, 0x9c, 0x5a, 0x54, 0x96, 0x99, 0x9c, 0xea, 0xc4, 0x11, 0xc5, 0x06, 0x71,
-	0x4e, 0x12, 0x1b, 0xd8, 0x29, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x72, 0x6e, 0x8c,
-	0xa4, 0x00, 0x00, 0x00,
+func (m *Blog) GetStatus() Blog_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Blog_DRAFT
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *Blog) GetPublishAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.PublishAt
+	}
+	return nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *Blog) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
 
-// BlogServiceClient is the client API for BlogService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BlogServiceClient interface {
+func (m *Blog) GetDeletedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return nil
 }
 
-type blogServiceClient struct {
-	cc *grpc.ClientConn
+type CreateBlogRequest struct {
+	// the blog is created as a DRAFT, see PublishBlog
+	Blog                 *Blog    `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewBlogServiceClient(cc *grpc.ClientConn) BlogServiceClient {
-	return &blogServiceClient{cc}
+func (m *CreateBlogRequest) Reset()         { *m = CreateBlogRequest{} }
+func (m *CreateBlogRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateBlogRequest) ProtoMessage()    {}
+func (*CreateBlogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{2}
 }
 
-// BlogServiceServer is the server API for BlogService service.
-type BlogServiceServer interface {
+func (m *CreateBlogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateBlogRequest.Unmarshal(m, b)
+}
+func (m *CreateBlogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateBlogRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateBlogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateBlogRequest.Merge(m, src)
+}
+func (m *CreateBlogRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateBlogRequest.Size(m)
+}
+func (m *CreateBlogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateBlogRequest.DiscardUnknown(m)
 }
 
-// UnimplementedBlogServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedBlogServiceServer struct {
+var xxx_messageInfo_CreateBlogRequest proto.InternalMessageInfo
+
+func (m *CreateBlogRequest) GetBlog() *Blog {
+	if m != nil {
+		return m.Blog
+	}
+	return nil
 }
 
-func RegisterBlogServiceServer(s *grpc.Server, srv BlogServiceServer) {
-	s.RegisterService(&_BlogService_serviceDesc, srv)
+type CreateBlogResponse struct {
+	Blog                 *Blog    `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateBlogResponse) Reset()         { *m = CreateBlogResponse{} }
+func (m *CreateBlogResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateBlogResponse) ProtoMessage()    {}
+func (*CreateBlogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{3}
+}
+
+func (m *CreateBlogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateBlogResponse.Unmarshal(m, b)
+}
+func (m *CreateBlogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateBlogResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateBlogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateBlogResponse.Merge(m, src)
+}
+func (m *CreateBlogResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateBlogResponse.Size(m)
+}
+func (m *CreateBlogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateBlogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateBlogResponse proto.InternalMessageInfo
+
+func (m *CreateBlogResponse) GetBlog() *Blog {
+	if m != nil {
+		return m.Blog
+	}
+	return nil
+}
+
+type ReadBlogRequest struct {
+	BlogId string `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// only return these fields of the blog, every field if empty
+	ReadMask             *field_mask.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReadBlogRequest) Reset()         { *m = ReadBlogRequest{} }
+func (m *ReadBlogRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadBlogRequest) ProtoMessage()    {}
+func (*ReadBlogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{4}
+}
+
+func (m *ReadBlogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadBlogRequest.Unmarshal(m, b)
+}
+func (m *ReadBlogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadBlogRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadBlogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadBlogRequest.Merge(m, src)
+}
+func (m *ReadBlogRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadBlogRequest.Size(m)
+}
+func (m *ReadBlogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadBlogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadBlogRequest proto.InternalMessageInfo
+
+func (m *ReadBlogRequest) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+func (m *ReadBlogRequest) GetReadMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.ReadMask
+	}
+	return nil
+}
+
+type ReadBlogResponse struct {
+	Blog                 *Blog    `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadBlogResponse) Reset()         { *m = ReadBlogResponse{} }
+func (m *ReadBlogResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadBlogResponse) ProtoMessage()    {}
+func (*ReadBlogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{5}
+}
+
+func (m *ReadBlogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadBlogResponse.Unmarshal(m, b)
+}
+func (m *ReadBlogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadBlogResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadBlogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadBlogResponse.Merge(m, src)
+}
+func (m *ReadBlogResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadBlogResponse.Size(m)
+}
+func (m *ReadBlogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadBlogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadBlogResponse proto.InternalMessageInfo
+
+func (m *ReadBlogResponse) GetBlog() *Blog {
+	if m != nil {
+		return m.Blog
+	}
+	return nil
+}
+
+type UpdateBlogRequest struct {
+	// blog.version is the version the client last read, not checked if 0
+	Blog *Blog `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	// who made this change, recorded in the revision history
+	EditorId string `protobuf:"bytes,2,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	// only change these fields and keep the others, replace the whole blog
+	// if empty. The id, version, status, publish_at and deleted_at cannot
+	// be listed, and the status and publish_at are always kept.
+	UpdateMask           *field_mask.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateBlogRequest) Reset()         { *m = UpdateBlogRequest{} }
+func (m *UpdateBlogRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateBlogRequest) ProtoMessage()    {}
+func (*UpdateBlogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{6}
+}
+
+func (m *UpdateBlogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateBlogRequest.Unmarshal(m, b)
+}
+func (m *UpdateBlogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateBlogRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateBlogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateBlogRequest.Merge(m, src)
+}
+func (m *UpdateBlogRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateBlogRequest.Size(m)
+}
+func (m *UpdateBlogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateBlogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateBlogRequest proto.InternalMessageInfo
+
+func (m *UpdateBlogRequest) GetBlog() *Blog {
+	if m != nil {
+		return m.Blog
+	}
+	return nil
+}
+
+func (m *UpdateBlogRequest) GetEditorId() string {
+	if m != nil {
+		return m.EditorId
+	}
+	return ""
+}
+
+func (m *UpdateBlogRequest) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+type UpdateBlogResponse struct {
+	Blog                 *Blog    `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateBlogResponse) Reset()         { *m = UpdateBlogResponse{} }
+func (m *UpdateBlogResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateBlogResponse) ProtoMessage()    {}
+func (*UpdateBlogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{7}
+}
+
+func (m *UpdateBlogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateBlogResponse.Unmarshal(m, b)
+}
+func (m *UpdateBlogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateBlogResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateBlogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateBlogResponse.Merge(m, src)
+}
+func (m *UpdateBlogResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateBlogResponse.Size(m)
+}
+func (m *UpdateBlogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateBlogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateBlogResponse proto.InternalMessageInfo
+
+func (m *UpdateBlogResponse) GetBlog() *Blog {
+	if m != nil {
+		return m.Blog
+	}
+	return nil
+}
+
+type DeleteBlogRequest struct {
+	BlogId string `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// the version the client last read, not checked if 0
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteBlogRequest) Reset()         { *m = DeleteBlogRequest{} }
+func (m *DeleteBlogRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteBlogRequest) ProtoMessage()    {}
+func (*DeleteBlogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{8}
+}
+
+func (m *DeleteBlogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteBlogRequest.Unmarshal(m, b)
+}
+func (m *DeleteBlogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteBlogRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteBlogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteBlogRequest.Merge(m, src)
+}
+func (m *DeleteBlogRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteBlogRequest.Size(m)
+}
+func (m *DeleteBlogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteBlogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteBlogRequest proto.InternalMessageInfo
+
+func (m *DeleteBlogRequest) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+func (m *DeleteBlogRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type DeleteBlogResponse struct {
+	BlogId               string   `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteBlogResponse) Reset()         { *m = DeleteBlogResponse{} }
+func (m *DeleteBlogResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteBlogResponse) ProtoMessage()    {}
+func (*DeleteBlogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{9}
+}
+
+func (m *DeleteBlogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteBlogResponse.Unmarshal(m, b)
+}
+func (m *DeleteBlogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteBlogResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteBlogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteBlogResponse.Merge(m, src)
+}
+func (m *DeleteBlogResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteBlogResponse.Size(m)
+}
+func (m *DeleteBlogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteBlogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteBlogResponse proto.InternalMessageInfo
+
+func (m *DeleteBlogResponse) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+type RestoreBlogRequest struct {
+	BlogId               string   `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreBlogRequest) Reset()         { *m = RestoreBlogRequest{} }
+func (m *RestoreBlogRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreBlogRequest) ProtoMessage()    {}
+func (*RestoreBlogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{10}
+}
+
+func (m *RestoreBlogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreBlogRequest.Unmarshal(m, b)
+}
+func (m *RestoreBlogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreBlogRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreBlogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreBlogRequest.Merge(m, src)
+}
+func (m *RestoreBlogRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreBlogRequest.Size(m)
+}
+func (m *RestoreBlogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreBlogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreBlogRequest proto.InternalMessageInfo
+
+func (m *RestoreBlogRequest) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+type RestoreBlogResponse struct {
+	Blog                 *Blog    `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreBlogResponse) Reset()         { *m = RestoreBlogResponse{} }
+func (m *RestoreBlogResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreBlogResponse) ProtoMessage()    {}
+func (*RestoreBlogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{11}
+}
+
+func (m *RestoreBlogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreBlogResponse.Unmarshal(m, b)
+}
+func (m *RestoreBlogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreBlogResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreBlogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreBlogResponse.Merge(m, src)
+}
+func (m *RestoreBlogResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreBlogResponse.Size(m)
+}
+func (m *RestoreBlogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreBlogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreBlogResponse proto.InternalMessageInfo
+
+func (m *RestoreBlogResponse) GetBlog() *Blog {
+	if m != nil {
+		return m.Blog
+	}
+	return nil
+}
+
+type ListTrashRequest struct {
+	// only list the deleted blogs of this author if set
+	AuthorId             string   `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTrashRequest) Reset()         { *m = ListTrashRequest{} }
+func (m *ListTrashRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTrashRequest) ProtoMessage()    {}
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{12}
+}
+
+func (m *ListTrashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTrashRequest.Unmarshal(m, b)
+}
+func (m *ListTrashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTrashRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTrashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTrashRequest.Merge(m, src)
+}
+func (m *ListTrashRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTrashRequest.Size(m)
+}
+func (m *ListTrashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTrashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTrashRequest proto.InternalMessageInfo
+
+func (m *ListTrashRequest) GetAuthorId() string {
+	if m != nil {
+		return m.AuthorId
+	}
+	return ""
+}
+
+type ListTrashResponse struct {
+	// ordered by id, with deleted_at set
+	Blogs                []*Blog  `protobuf:"bytes,1,rep,name=blogs,proto3" json:"blogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTrashResponse) Reset()         { *m = ListTrashResponse{} }
+func (m *ListTrashResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTrashResponse) ProtoMessage()    {}
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{13}
+}
+
+func (m *ListTrashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTrashResponse.Unmarshal(m, b)
+}
+func (m *ListTrashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTrashResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTrashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTrashResponse.Merge(m, src)
+}
+func (m *ListTrashResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTrashResponse.Size(m)
+}
+func (m *ListTrashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTrashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTrashResponse proto.InternalMessageInfo
+
+func (m *ListTrashResponse) GetBlogs() []*Blog {
+	if m != nil {
+		return m.Blogs
+	}
+	return nil
+}
+
+type TagCount struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TagCount) Reset()         { *m = TagCount{} }
+func (m *TagCount) String() string { return proto.CompactTextString(m) }
+func (*TagCount) ProtoMessage()    {}
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{14}
+}
+
+func (m *TagCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagCount.Unmarshal(m, b)
+}
+func (m *TagCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagCount.Marshal(b, m, deterministic)
+}
+func (m *TagCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagCount.Merge(m, src)
+}
+func (m *TagCount) XXX_Size() int {
+	return xxx_messageInfo_TagCount.Size(m)
+}
+func (m *TagCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagCount proto.InternalMessageInfo
+
+func (m *TagCount) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *TagCount) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ListTagsRequest struct {
+	// only count blogs in one of these statuses, only published blogs if empty
+	Statuses             []Blog_Status `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=blog.Blog_Status" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListTagsRequest) Reset()         { *m = ListTagsRequest{} }
+func (m *ListTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTagsRequest) ProtoMessage()    {}
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{15}
+}
+
+func (m *ListTagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTagsRequest.Unmarshal(m, b)
+}
+func (m *ListTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTagsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTagsRequest.Merge(m, src)
+}
+func (m *ListTagsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTagsRequest.Size(m)
+}
+func (m *ListTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTagsRequest proto.InternalMessageInfo
+
+func (m *ListTagsRequest) GetStatuses() []Blog_Status {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+type ListTagsResponse struct {
+	// most used first
+	Tags                 []*TagCount `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListTagsResponse) Reset()         { *m = ListTagsResponse{} }
+func (m *ListTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTagsResponse) ProtoMessage()    {}
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{16}
+}
+
+func (m *ListTagsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTagsResponse.Unmarshal(m, b)
+}
+func (m *ListTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTagsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTagsResponse.Merge(m, src)
+}
+func (m *ListTagsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTagsResponse.Size(m)
+}
+func (m *ListTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTagsResponse proto.InternalMessageInfo
+
+func (m *ListTagsResponse) GetTags() []*TagCount {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type ListBlogRequest struct {
+	// only return blogs written by this author, all blogs if empty
+	AuthorId string `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// maximum number of blogs to stream, the server default is used if 0
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// resume after the blog this cursor points to, start from the beginning if empty
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// only return blogs in one of these statuses, only published blogs if empty
+	Statuses []Blog_Status `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=blog.Blog_Status" json:"statuses,omitempty"`
+	// only return blogs with any of these tags, or all of them if
+	// match_all_tags is set
+	Tags         []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	MatchAllTags bool     `protobuf:"varint,6,opt,name=match_all_tags,json=matchAllTags,proto3" json:"match_all_tags,omitempty"`
+	// only return these fields of the blogs, every field if empty.
+	// The filters always apply to the whole blogs.
+	ReadMask             *field_mask.FieldMask `protobuf:"bytes,7,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListBlogRequest) Reset()         { *m = ListBlogRequest{} }
+func (m *ListBlogRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBlogRequest) ProtoMessage()    {}
+func (*ListBlogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{17}
+}
+
+func (m *ListBlogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBlogRequest.Unmarshal(m, b)
+}
+func (m *ListBlogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBlogRequest.Marshal(b, m, deterministic)
+}
+func (m *ListBlogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBlogRequest.Merge(m, src)
+}
+func (m *ListBlogRequest) XXX_Size() int {
+	return xxx_messageInfo_ListBlogRequest.Size(m)
+}
+func (m *ListBlogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBlogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBlogRequest proto.InternalMessageInfo
+
+func (m *ListBlogRequest) GetAuthorId() string {
+	if m != nil {
+		return m.AuthorId
+	}
+	return ""
+}
+
+func (m *ListBlogRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListBlogRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ListBlogRequest) GetStatuses() []Blog_Status {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func (m *ListBlogRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ListBlogRequest) GetMatchAllTags() bool {
+	if m != nil {
+		return m.MatchAllTags
+	}
+	return false
+}
+
+func (m *ListBlogRequest) GetReadMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.ReadMask
+	}
+	return nil
+}
+
+type ListBlogResponse struct {
+	Blog *Blog `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	// pass this cursor in a new ListBlogRequest to resume right after this blog
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// the profile of the author of the blog
+	Author *Author `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	// only set in the first response, the number of blogs with each tag
+	// among all the blogs matching the request, most used first
+	Facets               []*TagCount `protobuf:"bytes,4,rep,name=facets,proto3" json:"facets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListBlogResponse) Reset()         { *m = ListBlogResponse{} }
+func (m *ListBlogResponse) String() string { return proto.CompactTextString(m) }
+func (*ListBlogResponse) ProtoMessage()    {}
+func (*ListBlogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{18}
+}
+
+func (m *ListBlogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBlogResponse.Unmarshal(m, b)
+}
+func (m *ListBlogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBlogResponse.Marshal(b, m, deterministic)
+}
+func (m *ListBlogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBlogResponse.Merge(m, src)
+}
+func (m *ListBlogResponse) XXX_Size() int {
+	return xxx_messageInfo_ListBlogResponse.Size(m)
+}
+func (m *ListBlogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBlogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBlogResponse proto.InternalMessageInfo
+
+func (m *ListBlogResponse) GetBlog() *Blog {
+	if m != nil {
+		return m.Blog
+	}
+	return nil
+}
+
+func (m *ListBlogResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ListBlogResponse) GetAuthor() *Author {
+	if m != nil {
+		return m.Author
+	}
+	return nil
+}
+
+func (m *ListBlogResponse) GetFacets() []*TagCount {
+	if m != nil {
+		return m.Facets
+	}
+	return nil
+}
+
+type SearchBlogRequest struct {
+	// words to look for in the title and content of blogs
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// maximum number of results, the server default is used if 0
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// only return blogs with any of these tags, or all of them if
+	// match_all_tags is set
+	Tags                 []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	MatchAllTags         bool     `protobuf:"varint,4,opt,name=match_all_tags,json=matchAllTags,proto3" json:"match_all_tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchBlogRequest) Reset()         { *m = SearchBlogRequest{} }
+func (m *SearchBlogRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchBlogRequest) ProtoMessage()    {}
+func (*SearchBlogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{19}
+}
+
+func (m *SearchBlogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchBlogRequest.Unmarshal(m, b)
+}
+func (m *SearchBlogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchBlogRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchBlogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchBlogRequest.Merge(m, src)
+}
+func (m *SearchBlogRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchBlogRequest.Size(m)
+}
+func (m *SearchBlogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchBlogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchBlogRequest proto.InternalMessageInfo
+
+func (m *SearchBlogRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchBlogRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SearchBlogRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *SearchBlogRequest) GetMatchAllTags() bool {
+	if m != nil {
+		return m.MatchAllTags
+	}
+	return false
+}
+
+type SearchBlogResult struct {
+	Blog *Blog `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	// higher is a better match
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// excerpt of the content with matching words wrapped in <em> tags
+	Snippet              string   `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchBlogResult) Reset()         { *m = SearchBlogResult{} }
+func (m *SearchBlogResult) String() string { return proto.CompactTextString(m) }
+func (*SearchBlogResult) ProtoMessage()    {}
+func (*SearchBlogResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{20}
+}
+
+func (m *SearchBlogResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchBlogResult.Unmarshal(m, b)
+}
+func (m *SearchBlogResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchBlogResult.Marshal(b, m, deterministic)
+}
+func (m *SearchBlogResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchBlogResult.Merge(m, src)
+}
+func (m *SearchBlogResult) XXX_Size() int {
+	return xxx_messageInfo_SearchBlogResult.Size(m)
+}
+func (m *SearchBlogResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchBlogResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchBlogResult proto.InternalMessageInfo
+
+func (m *SearchBlogResult) GetBlog() *Blog {
+	if m != nil {
+		return m.Blog
+	}
+	return nil
+}
+
+func (m *SearchBlogResult) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *SearchBlogResult) GetSnippet() string {
+	if m != nil {
+		return m.Snippet
+	}
+	return ""
+}
+
+type SearchBlogResponse struct {
+	// best match first
+	Results []*SearchBlogResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// the number of blogs with each tag among all the blogs matching
+	// the search, most used first
+	Facets               []*TagCount `protobuf:"bytes,2,rep,name=facets,proto3" json:"facets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SearchBlogResponse) Reset()         { *m = SearchBlogResponse{} }
+func (m *SearchBlogResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchBlogResponse) ProtoMessage()    {}
+func (*SearchBlogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{21}
+}
+
+func (m *SearchBlogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchBlogResponse.Unmarshal(m, b)
+}
+func (m *SearchBlogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchBlogResponse.Marshal(b, m, deterministic)
+}
+func (m *SearchBlogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchBlogResponse.Merge(m, src)
+}
+func (m *SearchBlogResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchBlogResponse.Size(m)
+}
+func (m *SearchBlogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchBlogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchBlogResponse proto.InternalMessageInfo
+
+func (m *SearchBlogResponse) GetResults() []*SearchBlogResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SearchBlogResponse) GetFacets() []*TagCount {
+	if m != nil {
+		return m.Facets
+	}
+	return nil
+}
+
+// BlogRevision is an immutable copy of a blog saved every time it changes
+type BlogRevision struct {
+	BlogId string `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// starts at 1 when the blog is created
+	Revision             int64                `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Blog                 *Blog                `protobuf:"bytes,3,opt,name=blog,proto3" json:"blog,omitempty"`
+	EditorId             string               `protobuf:"bytes,4,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BlogRevision) Reset()         { *m = BlogRevision{} }
+func (m *BlogRevision) String() string { return proto.CompactTextString(m) }
+func (*BlogRevision) ProtoMessage()    {}
+func (*BlogRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{22}
+}
+
+func (m *BlogRevision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlogRevision.Unmarshal(m, b)
+}
+func (m *BlogRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlogRevision.Marshal(b, m, deterministic)
+}
+func (m *BlogRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlogRevision.Merge(m, src)
+}
+func (m *BlogRevision) XXX_Size() int {
+	return xxx_messageInfo_BlogRevision.Size(m)
+}
+func (m *BlogRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlogRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlogRevision proto.InternalMessageInfo
+
+func (m *BlogRevision) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+func (m *BlogRevision) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *BlogRevision) GetBlog() *Blog {
+	if m != nil {
+		return m.Blog
+	}
+	return nil
+}
+
+func (m *BlogRevision) GetEditorId() string {
+	if m != nil {
+		return m.EditorId
+	}
+	return ""
+}
+
+func (m *BlogRevision) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type ListBlogRevisionsRequest struct {
+	BlogId               string   `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListBlogRevisionsRequest) Reset()         { *m = ListBlogRevisionsRequest{} }
+func (m *ListBlogRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBlogRevisionsRequest) ProtoMessage()    {}
+func (*ListBlogRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{23}
+}
+
+func (m *ListBlogRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBlogRevisionsRequest.Unmarshal(m, b)
+}
+func (m *ListBlogRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBlogRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListBlogRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBlogRevisionsRequest.Merge(m, src)
+}
+func (m *ListBlogRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListBlogRevisionsRequest.Size(m)
+}
+func (m *ListBlogRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBlogRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBlogRevisionsRequest proto.InternalMessageInfo
+
+func (m *ListBlogRevisionsRequest) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+type ListBlogRevisionsResponse struct {
+	// oldest first
+	Revisions            []*BlogRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListBlogRevisionsResponse) Reset()         { *m = ListBlogRevisionsResponse{} }
+func (m *ListBlogRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListBlogRevisionsResponse) ProtoMessage()    {}
+func (*ListBlogRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{24}
+}
+
+func (m *ListBlogRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBlogRevisionsResponse.Unmarshal(m, b)
+}
+func (m *ListBlogRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBlogRevisionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListBlogRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBlogRevisionsResponse.Merge(m, src)
+}
+func (m *ListBlogRevisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListBlogRevisionsResponse.Size(m)
+}
+func (m *ListBlogRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBlogRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBlogRevisionsResponse proto.InternalMessageInfo
+
+func (m *ListBlogRevisionsResponse) GetRevisions() []*BlogRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+type GetBlogRevisionRequest struct {
+	BlogId               string   `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	Revision             int64    `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlogRevisionRequest) Reset()         { *m = GetBlogRevisionRequest{} }
+func (m *GetBlogRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlogRevisionRequest) ProtoMessage()    {}
+func (*GetBlogRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{25}
+}
+
+func (m *GetBlogRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlogRevisionRequest.Unmarshal(m, b)
+}
+func (m *GetBlogRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlogRevisionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlogRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlogRevisionRequest.Merge(m, src)
+}
+func (m *GetBlogRevisionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlogRevisionRequest.Size(m)
+}
+func (m *GetBlogRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlogRevisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlogRevisionRequest proto.InternalMessageInfo
+
+func (m *GetBlogRevisionRequest) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+func (m *GetBlogRevisionRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+type GetBlogRevisionResponse struct {
+	Revision             *BlogRevision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetBlogRevisionResponse) Reset()         { *m = GetBlogRevisionResponse{} }
+func (m *GetBlogRevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlogRevisionResponse) ProtoMessage()    {}
+func (*GetBlogRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{26}
+}
+
+func (m *GetBlogRevisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlogRevisionResponse.Unmarshal(m, b)
+}
+func (m *GetBlogRevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlogRevisionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBlogRevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlogRevisionResponse.Merge(m, src)
+}
+func (m *GetBlogRevisionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBlogRevisionResponse.Size(m)
+}
+func (m *GetBlogRevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlogRevisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlogRevisionResponse proto.InternalMessageInfo
+
+func (m *GetBlogRevisionResponse) GetRevision() *BlogRevision {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
+type RevertBlogRequest struct {
+	BlogId string `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// the revision to go back to
+	Revision             int64    `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	EditorId             string   `protobuf:"bytes,3,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevertBlogRequest) Reset()         { *m = RevertBlogRequest{} }
+func (m *RevertBlogRequest) String() string { return proto.CompactTextString(m) }
+func (*RevertBlogRequest) ProtoMessage()    {}
+func (*RevertBlogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{27}
+}
+
+func (m *RevertBlogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevertBlogRequest.Unmarshal(m, b)
+}
+func (m *RevertBlogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevertBlogRequest.Marshal(b, m, deterministic)
+}
+func (m *RevertBlogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertBlogRequest.Merge(m, src)
+}
+func (m *RevertBlogRequest) XXX_Size() int {
+	return xxx_messageInfo_RevertBlogRequest.Size(m)
+}
+func (m *RevertBlogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertBlogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertBlogRequest proto.InternalMessageInfo
+
+func (m *RevertBlogRequest) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+func (m *RevertBlogRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *RevertBlogRequest) GetEditorId() string {
+	if m != nil {
+		return m.EditorId
+	}
+	return ""
+}
+
+type RevertBlogResponse struct {
+	Blog *Blog `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	// the revision created by the revert
+	Revision             int64    `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevertBlogResponse) Reset()         { *m = RevertBlogResponse{} }
+func (m *RevertBlogResponse) String() string { return proto.CompactTextString(m) }
+func (*RevertBlogResponse) ProtoMessage()    {}
+func (*RevertBlogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{28}
+}
+
+func (m *RevertBlogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevertBlogResponse.Unmarshal(m, b)
+}
+func (m *RevertBlogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevertBlogResponse.Marshal(b, m, deterministic)
+}
+func (m *RevertBlogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertBlogResponse.Merge(m, src)
+}
+func (m *RevertBlogResponse) XXX_Size() int {
+	return xxx_messageInfo_RevertBlogResponse.Size(m)
+}
+func (m *RevertBlogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertBlogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertBlogResponse proto.InternalMessageInfo
+
+func (m *RevertBlogResponse) GetBlog() *Blog {
+	if m != nil {
+		return m.Blog
+	}
+	return nil
+}
+
+func (m *RevertBlogResponse) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+// VersionConflict is sent in the details of the ABORTED status returned
+// when a client changes a blog using a stale version
+type VersionConflict struct {
+	BlogId               string   `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	CurrentVersion       int64    `protobuf:"varint,2,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VersionConflict) Reset()         { *m = VersionConflict{} }
+func (m *VersionConflict) String() string { return proto.CompactTextString(m) }
+func (*VersionConflict) ProtoMessage()    {}
+func (*VersionConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{29}
+}
+
+func (m *VersionConflict) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionConflict.Unmarshal(m, b)
+}
+func (m *VersionConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionConflict.Marshal(b, m, deterministic)
+}
+func (m *VersionConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionConflict.Merge(m, src)
+}
+func (m *VersionConflict) XXX_Size() int {
+	return xxx_messageInfo_VersionConflict.Size(m)
+}
+func (m *VersionConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionConflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionConflict proto.InternalMessageInfo
+
+func (m *VersionConflict) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+func (m *VersionConflict) GetCurrentVersion() int64 {
+	if m != nil {
+		return m.CurrentVersion
+	}
+	return 0
+}
+
+// BlogEvent is a change made to a blog
+type BlogEvent struct {
+	// increases by one for every event, use it to resume watching
+	Sequence int64          `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type     BlogEvent_Type `protobuf:"varint,2,opt,name=type,proto3,enum=blog.BlogEvent_Type" json:"type,omitempty"`
+	BlogId   string         `protobuf:"bytes,3,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// the blog after the change, or the last version of a deleted blog
+	Blog                 *Blog                `protobuf:"bytes,4,opt,name=blog,proto3" json:"blog,omitempty"`
+	OccurredAt           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BlogEvent) Reset()         { *m = BlogEvent{} }
+func (m *BlogEvent) String() string { return proto.CompactTextString(m) }
+func (*BlogEvent) ProtoMessage()    {}
+func (*BlogEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{30}
+}
+
+func (m *BlogEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlogEvent.Unmarshal(m, b)
+}
+func (m *BlogEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlogEvent.Marshal(b, m, deterministic)
+}
+func (m *BlogEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlogEvent.Merge(m, src)
+}
+func (m *BlogEvent) XXX_Size() int {
+	return xxx_messageInfo_BlogEvent.Size(m)
+}
+func (m *BlogEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlogEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlogEvent proto.InternalMessageInfo
+
+func (m *BlogEvent) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *BlogEvent) GetType() BlogEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return BlogEvent_UNKNOWN
+}
+
+func (m *BlogEvent) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+func (m *BlogEvent) GetBlog() *Blog {
+	if m != nil {
+		return m.Blog
+	}
+	return nil
+}
+
+func (m *BlogEvent) GetOccurredAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.OccurredAt
+	}
+	return nil
+}
+
+type WatchBlogsRequest struct {
+	// only send events of blogs written by this author, all events if empty
+	AuthorId string `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// send the events that happened after this sequence number first,
+	// only new events are sent if 0
+	FromSequence         int64    `protobuf:"varint,2,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchBlogsRequest) Reset()         { *m = WatchBlogsRequest{} }
+func (m *WatchBlogsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchBlogsRequest) ProtoMessage()    {}
+func (*WatchBlogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{31}
+}
+
+func (m *WatchBlogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchBlogsRequest.Unmarshal(m, b)
+}
+func (m *WatchBlogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchBlogsRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchBlogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchBlogsRequest.Merge(m, src)
+}
+func (m *WatchBlogsRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchBlogsRequest.Size(m)
+}
+func (m *WatchBlogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchBlogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchBlogsRequest proto.InternalMessageInfo
+
+func (m *WatchBlogsRequest) GetAuthorId() string {
+	if m != nil {
+		return m.AuthorId
+	}
+	return ""
+}
+
+func (m *WatchBlogsRequest) GetFromSequence() int64 {
+	if m != nil {
+		return m.FromSequence
+	}
+	return 0
+}
+
+type WatchBlogsResponse struct {
+	Event                *BlogEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *WatchBlogsResponse) Reset()         { *m = WatchBlogsResponse{} }
+func (m *WatchBlogsResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchBlogsResponse) ProtoMessage()    {}
+func (*WatchBlogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{32}
+}
+
+func (m *WatchBlogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchBlogsResponse.Unmarshal(m, b)
+}
+func (m *WatchBlogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchBlogsResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchBlogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchBlogsResponse.Merge(m, src)
+}
+func (m *WatchBlogsResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchBlogsResponse.Size(m)
+}
+func (m *WatchBlogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchBlogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchBlogsResponse proto.InternalMessageInfo
+
+func (m *WatchBlogsResponse) GetEvent() *BlogEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+// Comment is a reader reaction to a blog or to another comment
+type Comment struct {
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BlogId string `protobuf:"bytes,2,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// the comment this one replies to, empty for a top level comment
+	ParentId             string               `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	AuthorId             string               `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Content              string               `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Comment) Reset()         { *m = Comment{} }
+func (m *Comment) String() string { return proto.CompactTextString(m) }
+func (*Comment) ProtoMessage()    {}
+func (*Comment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{33}
+}
+
+func (m *Comment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Comment.Unmarshal(m, b)
+}
+func (m *Comment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Comment.Marshal(b, m, deterministic)
+}
+func (m *Comment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Comment.Merge(m, src)
+}
+func (m *Comment) XXX_Size() int {
+	return xxx_messageInfo_Comment.Size(m)
+}
+func (m *Comment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Comment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Comment proto.InternalMessageInfo
+
+func (m *Comment) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Comment) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+func (m *Comment) GetParentId() string {
+	if m != nil {
+		return m.ParentId
+	}
+	return ""
+}
+
+func (m *Comment) GetAuthorId() string {
+	if m != nil {
+		return m.AuthorId
+	}
+	return ""
+}
+
+func (m *Comment) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *Comment) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type AddCommentRequest struct {
+	Comment              *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddCommentRequest) Reset()         { *m = AddCommentRequest{} }
+func (m *AddCommentRequest) String() string { return proto.CompactTextString(m) }
+func (*AddCommentRequest) ProtoMessage()    {}
+func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{34}
+}
+
+func (m *AddCommentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCommentRequest.Unmarshal(m, b)
+}
+func (m *AddCommentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCommentRequest.Marshal(b, m, deterministic)
+}
+func (m *AddCommentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCommentRequest.Merge(m, src)
+}
+func (m *AddCommentRequest) XXX_Size() int {
+	return xxx_messageInfo_AddCommentRequest.Size(m)
+}
+func (m *AddCommentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCommentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCommentRequest proto.InternalMessageInfo
+
+func (m *AddCommentRequest) GetComment() *Comment {
+	if m != nil {
+		return m.Comment
+	}
+	return nil
+}
+
+type AddCommentResponse struct {
+	Comment              *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddCommentResponse) Reset()         { *m = AddCommentResponse{} }
+func (m *AddCommentResponse) String() string { return proto.CompactTextString(m) }
+func (*AddCommentResponse) ProtoMessage()    {}
+func (*AddCommentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{35}
+}
+
+func (m *AddCommentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCommentResponse.Unmarshal(m, b)
+}
+func (m *AddCommentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCommentResponse.Marshal(b, m, deterministic)
+}
+func (m *AddCommentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCommentResponse.Merge(m, src)
+}
+func (m *AddCommentResponse) XXX_Size() int {
+	return xxx_messageInfo_AddCommentResponse.Size(m)
+}
+func (m *AddCommentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCommentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCommentResponse proto.InternalMessageInfo
+
+func (m *AddCommentResponse) GetComment() *Comment {
+	if m != nil {
+		return m.Comment
+	}
+	return nil
+}
+
+type ListCommentsRequest struct {
+	BlogId string `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// only list the replies to this comment, the whole discussion if empty
+	ParentId             string   `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCommentsRequest) Reset()         { *m = ListCommentsRequest{} }
+func (m *ListCommentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCommentsRequest) ProtoMessage()    {}
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{36}
+}
+
+func (m *ListCommentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCommentsRequest.Unmarshal(m, b)
+}
+func (m *ListCommentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCommentsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCommentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCommentsRequest.Merge(m, src)
+}
+func (m *ListCommentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCommentsRequest.Size(m)
+}
+func (m *ListCommentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCommentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCommentsRequest proto.InternalMessageInfo
+
+func (m *ListCommentsRequest) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+func (m *ListCommentsRequest) GetParentId() string {
+	if m != nil {
+		return m.ParentId
+	}
+	return ""
+}
+
+type ListCommentsResponse struct {
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	// 0 for the comments replying to the listed parent, 1 for their replies...
+	Depth                int32    `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCommentsResponse) Reset()         { *m = ListCommentsResponse{} }
+func (m *ListCommentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCommentsResponse) ProtoMessage()    {}
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{37}
+}
+
+func (m *ListCommentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCommentsResponse.Unmarshal(m, b)
+}
+func (m *ListCommentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCommentsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCommentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCommentsResponse.Merge(m, src)
+}
+func (m *ListCommentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCommentsResponse.Size(m)
+}
+func (m *ListCommentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCommentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCommentsResponse proto.InternalMessageInfo
+
+func (m *ListCommentsResponse) GetComment() *Comment {
+	if m != nil {
+		return m.Comment
+	}
+	return nil
+}
+
+func (m *ListCommentsResponse) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+type DeleteCommentRequest struct {
+	BlogId               string   `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	CommentId            string   `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCommentRequest) Reset()         { *m = DeleteCommentRequest{} }
+func (m *DeleteCommentRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCommentRequest) ProtoMessage()    {}
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{38}
+}
+
+func (m *DeleteCommentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCommentRequest.Unmarshal(m, b)
+}
+func (m *DeleteCommentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCommentRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteCommentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCommentRequest.Merge(m, src)
+}
+func (m *DeleteCommentRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCommentRequest.Size(m)
+}
+func (m *DeleteCommentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCommentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCommentRequest proto.InternalMessageInfo
+
+func (m *DeleteCommentRequest) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+func (m *DeleteCommentRequest) GetCommentId() string {
+	if m != nil {
+		return m.CommentId
+	}
+	return ""
+}
+
+type DeleteCommentResponse struct {
+	CommentId            string   `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCommentResponse) Reset()         { *m = DeleteCommentResponse{} }
+func (m *DeleteCommentResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteCommentResponse) ProtoMessage()    {}
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{39}
+}
+
+func (m *DeleteCommentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCommentResponse.Unmarshal(m, b)
+}
+func (m *DeleteCommentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCommentResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteCommentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCommentResponse.Merge(m, src)
+}
+func (m *DeleteCommentResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteCommentResponse.Size(m)
+}
+func (m *DeleteCommentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCommentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCommentResponse proto.InternalMessageInfo
+
+func (m *DeleteCommentResponse) GetCommentId() string {
+	if m != nil {
+		return m.CommentId
+	}
+	return ""
+}
+
+type CreateAuthorRequest struct {
+	// a random id is assigned if author.id is empty
+	Author               *Author  `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAuthorRequest) Reset()         { *m = CreateAuthorRequest{} }
+func (m *CreateAuthorRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAuthorRequest) ProtoMessage()    {}
+func (*CreateAuthorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{40}
+}
+
+func (m *CreateAuthorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAuthorRequest.Unmarshal(m, b)
+}
+func (m *CreateAuthorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAuthorRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateAuthorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAuthorRequest.Merge(m, src)
+}
+func (m *CreateAuthorRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAuthorRequest.Size(m)
+}
+func (m *CreateAuthorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAuthorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAuthorRequest proto.InternalMessageInfo
+
+func (m *CreateAuthorRequest) GetAuthor() *Author {
+	if m != nil {
+		return m.Author
+	}
+	return nil
+}
+
+type CreateAuthorResponse struct {
+	Author               *Author  `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAuthorResponse) Reset()         { *m = CreateAuthorResponse{} }
+func (m *CreateAuthorResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAuthorResponse) ProtoMessage()    {}
+func (*CreateAuthorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{41}
+}
+
+func (m *CreateAuthorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAuthorResponse.Unmarshal(m, b)
+}
+func (m *CreateAuthorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAuthorResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateAuthorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAuthorResponse.Merge(m, src)
+}
+func (m *CreateAuthorResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAuthorResponse.Size(m)
+}
+func (m *CreateAuthorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAuthorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAuthorResponse proto.InternalMessageInfo
+
+func (m *CreateAuthorResponse) GetAuthor() *Author {
+	if m != nil {
+		return m.Author
+	}
+	return nil
+}
+
+type GetAuthorRequest struct {
+	AuthorId             string   `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAuthorRequest) Reset()         { *m = GetAuthorRequest{} }
+func (m *GetAuthorRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAuthorRequest) ProtoMessage()    {}
+func (*GetAuthorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{42}
+}
+
+func (m *GetAuthorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuthorRequest.Unmarshal(m, b)
+}
+func (m *GetAuthorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuthorRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAuthorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuthorRequest.Merge(m, src)
+}
+func (m *GetAuthorRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAuthorRequest.Size(m)
+}
+func (m *GetAuthorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuthorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuthorRequest proto.InternalMessageInfo
+
+func (m *GetAuthorRequest) GetAuthorId() string {
+	if m != nil {
+		return m.AuthorId
+	}
+	return ""
+}
+
+type GetAuthorResponse struct {
+	Author               *Author  `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAuthorResponse) Reset()         { *m = GetAuthorResponse{} }
+func (m *GetAuthorResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAuthorResponse) ProtoMessage()    {}
+func (*GetAuthorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{43}
+}
+
+func (m *GetAuthorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuthorResponse.Unmarshal(m, b)
+}
+func (m *GetAuthorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuthorResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAuthorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuthorResponse.Merge(m, src)
+}
+func (m *GetAuthorResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAuthorResponse.Size(m)
+}
+func (m *GetAuthorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuthorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuthorResponse proto.InternalMessageInfo
+
+func (m *GetAuthorResponse) GetAuthor() *Author {
+	if m != nil {
+		return m.Author
+	}
+	return nil
+}
+
+type ListAuthorsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAuthorsRequest) Reset()         { *m = ListAuthorsRequest{} }
+func (m *ListAuthorsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuthorsRequest) ProtoMessage()    {}
+func (*ListAuthorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{44}
+}
+
+func (m *ListAuthorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuthorsRequest.Unmarshal(m, b)
+}
+func (m *ListAuthorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuthorsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAuthorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuthorsRequest.Merge(m, src)
+}
+func (m *ListAuthorsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuthorsRequest.Size(m)
+}
+func (m *ListAuthorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuthorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuthorsRequest proto.InternalMessageInfo
+
+type ListAuthorsResponse struct {
+	// ordered by id
+	Authors              []*Author `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListAuthorsResponse) Reset()         { *m = ListAuthorsResponse{} }
+func (m *ListAuthorsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuthorsResponse) ProtoMessage()    {}
+func (*ListAuthorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{45}
+}
+
+func (m *ListAuthorsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuthorsResponse.Unmarshal(m, b)
+}
+func (m *ListAuthorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuthorsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAuthorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuthorsResponse.Merge(m, src)
+}
+func (m *ListAuthorsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAuthorsResponse.Size(m)
+}
+func (m *ListAuthorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuthorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuthorsResponse proto.InternalMessageInfo
+
+func (m *ListAuthorsResponse) GetAuthors() []*Author {
+	if m != nil {
+		return m.Authors
+	}
+	return nil
+}
+
+type ImportBlogsRequest struct {
+	// blog.id is kept if set, a random id is assigned otherwise. The blog
+	// is imported as a DRAFT.
+	Blog                 *Blog    `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportBlogsRequest) Reset()         { *m = ImportBlogsRequest{} }
+func (m *ImportBlogsRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportBlogsRequest) ProtoMessage()    {}
+func (*ImportBlogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{46}
+}
+
+func (m *ImportBlogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportBlogsRequest.Unmarshal(m, b)
+}
+func (m *ImportBlogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportBlogsRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportBlogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportBlogsRequest.Merge(m, src)
+}
+func (m *ImportBlogsRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportBlogsRequest.Size(m)
+}
+func (m *ImportBlogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportBlogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportBlogsRequest proto.InternalMessageInfo
+
+func (m *ImportBlogsRequest) GetBlog() *Blog {
+	if m != nil {
+		return m.Blog
+	}
+	return nil
+}
+
+type ImportFailure struct {
+	// position of the blog in the stream, starting at 0
+	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	BlogId               string   `protobuf:"bytes,2,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportFailure) Reset()         { *m = ImportFailure{} }
+func (m *ImportFailure) String() string { return proto.CompactTextString(m) }
+func (*ImportFailure) ProtoMessage()    {}
+func (*ImportFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{47}
+}
+
+func (m *ImportFailure) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportFailure.Unmarshal(m, b)
+}
+func (m *ImportFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportFailure.Marshal(b, m, deterministic)
+}
+func (m *ImportFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportFailure.Merge(m, src)
+}
+func (m *ImportFailure) XXX_Size() int {
+	return xxx_messageInfo_ImportFailure.Size(m)
+}
+func (m *ImportFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportFailure proto.InternalMessageInfo
+
+func (m *ImportFailure) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ImportFailure) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+func (m *ImportFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ImportBlogsResponse struct {
+	Imported             int32            `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Failed               int32            `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	Failures             []*ImportFailure `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ImportBlogsResponse) Reset()         { *m = ImportBlogsResponse{} }
+func (m *ImportBlogsResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportBlogsResponse) ProtoMessage()    {}
+func (*ImportBlogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{48}
+}
+
+func (m *ImportBlogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportBlogsResponse.Unmarshal(m, b)
+}
+func (m *ImportBlogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportBlogsResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportBlogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportBlogsResponse.Merge(m, src)
+}
+func (m *ImportBlogsResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportBlogsResponse.Size(m)
+}
+func (m *ImportBlogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportBlogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportBlogsResponse proto.InternalMessageInfo
+
+func (m *ImportBlogsResponse) GetImported() int32 {
+	if m != nil {
+		return m.Imported
+	}
+	return 0
+}
+
+func (m *ImportBlogsResponse) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *ImportBlogsResponse) GetFailures() []*ImportFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+type ExportBlogsRequest struct {
+	// only export blogs written by this author, all blogs if empty
+	AuthorId             string   `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportBlogsRequest) Reset()         { *m = ExportBlogsRequest{} }
+func (m *ExportBlogsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportBlogsRequest) ProtoMessage()    {}
+func (*ExportBlogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{49}
+}
+
+func (m *ExportBlogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportBlogsRequest.Unmarshal(m, b)
+}
+func (m *ExportBlogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportBlogsRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportBlogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportBlogsRequest.Merge(m, src)
+}
+func (m *ExportBlogsRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportBlogsRequest.Size(m)
+}
+func (m *ExportBlogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportBlogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportBlogsRequest proto.InternalMessageInfo
+
+func (m *ExportBlogsRequest) GetAuthorId() string {
+	if m != nil {
+		return m.AuthorId
+	}
+	return ""
+}
+
+type ExportBlogsResponse struct {
+	Blog                 *Blog    `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportBlogsResponse) Reset()         { *m = ExportBlogsResponse{} }
+func (m *ExportBlogsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportBlogsResponse) ProtoMessage()    {}
+func (*ExportBlogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{50}
+}
+
+func (m *ExportBlogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportBlogsResponse.Unmarshal(m, b)
+}
+func (m *ExportBlogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportBlogsResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportBlogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportBlogsResponse.Merge(m, src)
+}
+func (m *ExportBlogsResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportBlogsResponse.Size(m)
+}
+func (m *ExportBlogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportBlogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportBlogsResponse proto.InternalMessageInfo
+
+func (m *ExportBlogsResponse) GetBlog() *Blog {
+	if m != nil {
+		return m.Blog
+	}
+	return nil
+}
+
+type RenderBlogRequest struct {
+	BlogId               string   `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenderBlogRequest) Reset()         { *m = RenderBlogRequest{} }
+func (m *RenderBlogRequest) String() string { return proto.CompactTextString(m) }
+func (*RenderBlogRequest) ProtoMessage()    {}
+func (*RenderBlogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{51}
+}
+
+func (m *RenderBlogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenderBlogRequest.Unmarshal(m, b)
+}
+func (m *RenderBlogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenderBlogRequest.Marshal(b, m, deterministic)
+}
+func (m *RenderBlogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderBlogRequest.Merge(m, src)
+}
+func (m *RenderBlogRequest) XXX_Size() int {
+	return xxx_messageInfo_RenderBlogRequest.Size(m)
+}
+func (m *RenderBlogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderBlogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenderBlogRequest proto.InternalMessageInfo
+
+func (m *RenderBlogRequest) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+type RenderBlogResponse struct {
+	BlogId string `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// the version of the blog that was rendered
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// the content as sanitized HTML, safe to embed in a page
+	Html string `protobuf:"bytes,3,opt,name=html,proto3" json:"html,omitempty"`
+	// the beginning of the content as plain text
+	Excerpt              string   `protobuf:"bytes,4,opt,name=excerpt,proto3" json:"excerpt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenderBlogResponse) Reset()         { *m = RenderBlogResponse{} }
+func (m *RenderBlogResponse) String() string { return proto.CompactTextString(m) }
+func (*RenderBlogResponse) ProtoMessage()    {}
+func (*RenderBlogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{52}
+}
+
+func (m *RenderBlogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenderBlogResponse.Unmarshal(m, b)
+}
+func (m *RenderBlogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenderBlogResponse.Marshal(b, m, deterministic)
+}
+func (m *RenderBlogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderBlogResponse.Merge(m, src)
+}
+func (m *RenderBlogResponse) XXX_Size() int {
+	return xxx_messageInfo_RenderBlogResponse.Size(m)
+}
+func (m *RenderBlogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderBlogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenderBlogResponse proto.InternalMessageInfo
+
+func (m *RenderBlogResponse) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+func (m *RenderBlogResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RenderBlogResponse) GetHtml() string {
+	if m != nil {
+		return m.Html
+	}
+	return ""
+}
+
+func (m *RenderBlogResponse) GetExcerpt() string {
+	if m != nil {
+		return m.Excerpt
+	}
+	return ""
+}
+
+type PublishBlogRequest struct {
+	BlogId string `protobuf:"bytes,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// publish the blog at this time, right away if empty or in the past
+	PublishAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// the version the client last read, not checked if 0
+	Version              int64    `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	EditorId             string   `protobuf:"bytes,4,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublishBlogRequest) Reset()         { *m = PublishBlogRequest{} }
+func (m *PublishBlogRequest) String() string { return proto.CompactTextString(m) }
+func (*PublishBlogRequest) ProtoMessage()    {}
+func (*PublishBlogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{53}
+}
+
+func (m *PublishBlogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishBlogRequest.Unmarshal(m, b)
+}
+func (m *PublishBlogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishBlogRequest.Marshal(b, m, deterministic)
+}
+func (m *PublishBlogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishBlogRequest.Merge(m, src)
+}
+func (m *PublishBlogRequest) XXX_Size() int {
+	return xxx_messageInfo_PublishBlogRequest.Size(m)
+}
+func (m *PublishBlogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishBlogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishBlogRequest proto.InternalMessageInfo
+
+func (m *PublishBlogRequest) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+func (m *PublishBlogRequest) GetPublishAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.PublishAt
+	}
+	return nil
+}
+
+func (m *PublishBlogRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PublishBlogRequest) GetEditorId() string {
+	if m != nil {
+		return m.EditorId
+	}
+	return ""
+}
+
+type PublishBlogResponse struct {
+	// PUBLISHED, or SCHEDULED if publish_at is in the future
+	Blog                 *Blog    `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublishBlogResponse) Reset()         { *m = PublishBlogResponse{} }
+func (m *PublishBlogResponse) String() string { return proto.CompactTextString(m) }
+func (*PublishBlogResponse) ProtoMessage()    {}
+func (*PublishBlogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{54}
+}
+
+func (m *PublishBlogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishBlogResponse.Unmarshal(m, b)
+}
+func (m *PublishBlogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishBlogResponse.Marshal(b, m, deterministic)
+}
+func (m *PublishBlogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishBlogResponse.Merge(m, src)
+}
+func (m *PublishBlogResponse) XXX_Size() int {
+	return xxx_messageInfo_PublishBlogResponse.Size(m)
+}
+func (m *PublishBlogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishBlogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishBlogResponse proto.InternalMessageInfo
+
+func (m *PublishBlogResponse) GetBlog() *Blog {
+	if m != nil {
+		return m.Blog
+	}
+	return nil
+}
+
+// Attachment describes a file attached to a blog, like an image
+type Attachment struct {
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BlogId      string `protobuf:"bytes,2,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	Filename    string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// in bytes
+	Size int64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// hex encoded SHA-256 of the content
+	Sha256               string               `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Attachment) Reset()         { *m = Attachment{} }
+func (m *Attachment) String() string { return proto.CompactTextString(m) }
+func (*Attachment) ProtoMessage()    {}
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{55}
+}
+
+func (m *Attachment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Attachment.Unmarshal(m, b)
+}
+func (m *Attachment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Attachment.Marshal(b, m, deterministic)
+}
+func (m *Attachment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attachment.Merge(m, src)
+}
+func (m *Attachment) XXX_Size() int {
+	return xxx_messageInfo_Attachment.Size(m)
+}
+func (m *Attachment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attachment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attachment proto.InternalMessageInfo
+
+func (m *Attachment) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Attachment) GetBlogId() string {
+	if m != nil {
+		return m.BlogId
+	}
+	return ""
+}
+
+func (m *Attachment) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *Attachment) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *Attachment) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *Attachment) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
+func (m *Attachment) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type UploadAttachmentRequest struct {
+	// Types that are valid to be assigned to Data:
+	//	*UploadAttachmentRequest_Info
+	//	*UploadAttachmentRequest_Chunk
+	Data                 isUploadAttachmentRequest_Data `protobuf_oneof:"data"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *UploadAttachmentRequest) Reset()         { *m = UploadAttachmentRequest{} }
+func (m *UploadAttachmentRequest) String() string { return proto.CompactTextString(m) }
+func (*UploadAttachmentRequest) ProtoMessage()    {}
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{56}
+}
+
+func (m *UploadAttachmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadAttachmentRequest.Unmarshal(m, b)
+}
+func (m *UploadAttachmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadAttachmentRequest.Marshal(b, m, deterministic)
+}
+func (m *UploadAttachmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadAttachmentRequest.Merge(m, src)
+}
+func (m *UploadAttachmentRequest) XXX_Size() int {
+	return xxx_messageInfo_UploadAttachmentRequest.Size(m)
+}
+func (m *UploadAttachmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadAttachmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadAttachmentRequest proto.InternalMessageInfo
+
+type isUploadAttachmentRequest_Data interface {
+	isUploadAttachmentRequest_Data()
+}
+
+type UploadAttachmentRequest_Info struct {
+	Info *Attachment `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Info) isUploadAttachmentRequest_Data() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Data() {}
+
+func (m *UploadAttachmentRequest) GetData() isUploadAttachmentRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *UploadAttachmentRequest) GetInfo() *Attachment {
+	if x, ok := m.GetData().(*UploadAttachmentRequest_Info); ok {
+		return x.Info
+	}
+	return nil
+}
+
+func (m *UploadAttachmentRequest) GetChunk() []byte {
+	if x, ok := m.GetData().(*UploadAttachmentRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*UploadAttachmentRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*UploadAttachmentRequest_Info)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+}
+
+type UploadAttachmentResponse struct {
+	Attachment           *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *UploadAttachmentResponse) Reset()         { *m = UploadAttachmentResponse{} }
+func (m *UploadAttachmentResponse) String() string { return proto.CompactTextString(m) }
+func (*UploadAttachmentResponse) ProtoMessage()    {}
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{57}
+}
+
+func (m *UploadAttachmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadAttachmentResponse.Unmarshal(m, b)
+}
+func (m *UploadAttachmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadAttachmentResponse.Marshal(b, m, deterministic)
+}
+func (m *UploadAttachmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadAttachmentResponse.Merge(m, src)
+}
+func (m *UploadAttachmentResponse) XXX_Size() int {
+	return xxx_messageInfo_UploadAttachmentResponse.Size(m)
+}
+func (m *UploadAttachmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadAttachmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadAttachmentResponse proto.InternalMessageInfo
+
+func (m *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if m != nil {
+		return m.Attachment
+	}
+	return nil
+}
+
+type DownloadAttachmentRequest struct {
+	AttachmentId         string   `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownloadAttachmentRequest) Reset()         { *m = DownloadAttachmentRequest{} }
+func (m *DownloadAttachmentRequest) String() string { return proto.CompactTextString(m) }
+func (*DownloadAttachmentRequest) ProtoMessage()    {}
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{58}
+}
+
+func (m *DownloadAttachmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadAttachmentRequest.Unmarshal(m, b)
+}
+func (m *DownloadAttachmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadAttachmentRequest.Marshal(b, m, deterministic)
+}
+func (m *DownloadAttachmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadAttachmentRequest.Merge(m, src)
+}
+func (m *DownloadAttachmentRequest) XXX_Size() int {
+	return xxx_messageInfo_DownloadAttachmentRequest.Size(m)
+}
+func (m *DownloadAttachmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadAttachmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadAttachmentRequest proto.InternalMessageInfo
+
+func (m *DownloadAttachmentRequest) GetAttachmentId() string {
+	if m != nil {
+		return m.AttachmentId
+	}
+	return ""
+}
+
+type DownloadAttachmentResponse struct {
+	// Types that are valid to be assigned to Data:
+	//	*DownloadAttachmentResponse_Info
+	//	*DownloadAttachmentResponse_Chunk
+	Data                 isDownloadAttachmentResponse_Data `protobuf_oneof:"data"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *DownloadAttachmentResponse) Reset()         { *m = DownloadAttachmentResponse{} }
+func (m *DownloadAttachmentResponse) String() string { return proto.CompactTextString(m) }
+func (*DownloadAttachmentResponse) ProtoMessage()    {}
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4b0406114889fe6, []int{59}
+}
+
+func (m *DownloadAttachmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadAttachmentResponse.Unmarshal(m, b)
+}
+func (m *DownloadAttachmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadAttachmentResponse.Marshal(b, m, deterministic)
+}
+func (m *DownloadAttachmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadAttachmentResponse.Merge(m, src)
+}
+func (m *DownloadAttachmentResponse) XXX_Size() int {
+	return xxx_messageInfo_DownloadAttachmentResponse.Size(m)
+}
+func (m *DownloadAttachmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadAttachmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadAttachmentResponse proto.InternalMessageInfo
+
+type isDownloadAttachmentResponse_Data interface {
+	isDownloadAttachmentResponse_Data()
+}
+
+type DownloadAttachmentResponse_Info struct {
+	Info *Attachment `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Info) isDownloadAttachmentResponse_Data() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Data() {}
+
+func (m *DownloadAttachmentResponse) GetData() isDownloadAttachmentResponse_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DownloadAttachmentResponse) GetInfo() *Attachment {
+	if x, ok := m.GetData().(*DownloadAttachmentResponse_Info); ok {
+		return x.Info
+	}
+	return nil
+}
+
+func (m *DownloadAttachmentResponse) GetChunk() []byte {
+	if x, ok := m.GetData().(*DownloadAttachmentResponse_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*DownloadAttachmentResponse) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*DownloadAttachmentResponse_Info)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterEnum("blog.Blog_Format", Blog_Format_name, Blog_Format_value)
+	proto.RegisterEnum("blog.Blog_Status", Blog_Status_name, Blog_Status_value)
+	proto.RegisterEnum("blog.BlogEvent_Type", BlogEvent_Type_name, BlogEvent_Type_value)
+	proto.RegisterType((*Author)(nil), "blog.Author")
+	proto.RegisterType((*Blog)(nil), "blog.Blog")
+	proto.RegisterType((*CreateBlogRequest)(nil), "blog.CreateBlogRequest")
+	proto.RegisterType((*CreateBlogResponse)(nil), "blog.CreateBlogResponse")
+	proto.RegisterType((*ReadBlogRequest)(nil), "blog.ReadBlogRequest")
+	proto.RegisterType((*ReadBlogResponse)(nil), "blog.ReadBlogResponse")
+	proto.RegisterType((*UpdateBlogRequest)(nil), "blog.UpdateBlogRequest")
+	proto.RegisterType((*UpdateBlogResponse)(nil), "blog.UpdateBlogResponse")
+	proto.RegisterType((*DeleteBlogRequest)(nil), "blog.DeleteBlogRequest")
+	proto.RegisterType((*DeleteBlogResponse)(nil), "blog.DeleteBlogResponse")
+	proto.RegisterType((*RestoreBlogRequest)(nil), "blog.RestoreBlogRequest")
+	proto.RegisterType((*RestoreBlogResponse)(nil), "blog.RestoreBlogResponse")
+	proto.RegisterType((*ListTrashRequest)(nil), "blog.ListTrashRequest")
+	proto.RegisterType((*ListTrashResponse)(nil), "blog.ListTrashResponse")
+	proto.RegisterType((*TagCount)(nil), "blog.TagCount")
+	proto.RegisterType((*ListTagsRequest)(nil), "blog.ListTagsRequest")
+	proto.RegisterType((*ListTagsResponse)(nil), "blog.ListTagsResponse")
+	proto.RegisterType((*ListBlogRequest)(nil), "blog.ListBlogRequest")
+	proto.RegisterType((*ListBlogResponse)(nil), "blog.ListBlogResponse")
+	proto.RegisterType((*SearchBlogRequest)(nil), "blog.SearchBlogRequest")
+	proto.RegisterType((*SearchBlogResult)(nil), "blog.SearchBlogResult")
+	proto.RegisterType((*SearchBlogResponse)(nil), "blog.SearchBlogResponse")
+	proto.RegisterType((*BlogRevision)(nil), "blog.BlogRevision")
+	proto.RegisterType((*ListBlogRevisionsRequest)(nil), "blog.ListBlogRevisionsRequest")
+	proto.RegisterType((*ListBlogRevisionsResponse)(nil), "blog.ListBlogRevisionsResponse")
+	proto.RegisterType((*GetBlogRevisionRequest)(nil), "blog.GetBlogRevisionRequest")
+	proto.RegisterType((*GetBlogRevisionResponse)(nil), "blog.GetBlogRevisionResponse")
+	proto.RegisterType((*RevertBlogRequest)(nil), "blog.RevertBlogRequest")
+	proto.RegisterType((*RevertBlogResponse)(nil), "blog.RevertBlogResponse")
+	proto.RegisterType((*VersionConflict)(nil), "blog.VersionConflict")
+	proto.RegisterType((*BlogEvent)(nil), "blog.BlogEvent")
+	proto.RegisterType((*WatchBlogsRequest)(nil), "blog.WatchBlogsRequest")
+	proto.RegisterType((*WatchBlogsResponse)(nil), "blog.WatchBlogsResponse")
+	proto.RegisterType((*Comment)(nil), "blog.Comment")
+	proto.RegisterType((*AddCommentRequest)(nil), "blog.AddCommentRequest")
+	proto.RegisterType((*AddCommentResponse)(nil), "blog.AddCommentResponse")
+	proto.RegisterType((*ListCommentsRequest)(nil), "blog.ListCommentsRequest")
+	proto.RegisterType((*ListCommentsResponse)(nil), "blog.ListCommentsResponse")
+	proto.RegisterType((*DeleteCommentRequest)(nil), "blog.DeleteCommentRequest")
+	proto.RegisterType((*DeleteCommentResponse)(nil), "blog.DeleteCommentResponse")
+	proto.RegisterType((*CreateAuthorRequest)(nil), "blog.CreateAuthorRequest")
+	proto.RegisterType((*CreateAuthorResponse)(nil), "blog.CreateAuthorResponse")
+	proto.RegisterType((*GetAuthorRequest)(nil), "blog.GetAuthorRequest")
+	proto.RegisterType((*GetAuthorResponse)(nil), "blog.GetAuthorResponse")
+	proto.RegisterType((*ListAuthorsRequest)(nil), "blog.ListAuthorsRequest")
+	proto.RegisterType((*ListAuthorsResponse)(nil), "blog.ListAuthorsResponse")
+	proto.RegisterType((*ImportBlogsRequest)(nil), "blog.ImportBlogsRequest")
+	proto.RegisterType((*ImportFailure)(nil), "blog.ImportFailure")
+	proto.RegisterType((*ImportBlogsResponse)(nil), "blog.ImportBlogsResponse")
+	proto.RegisterType((*ExportBlogsRequest)(nil), "blog.ExportBlogsRequest")
+	proto.RegisterType((*ExportBlogsResponse)(nil), "blog.ExportBlogsResponse")
+	proto.RegisterType((*RenderBlogRequest)(nil), "blog.RenderBlogRequest")
+	proto.RegisterType((*RenderBlogResponse)(nil), "blog.RenderBlogResponse")
+	proto.RegisterType((*PublishBlogRequest)(nil), "blog.PublishBlogRequest")
+	proto.RegisterType((*PublishBlogResponse)(nil), "blog.PublishBlogResponse")
+	proto.RegisterType((*Attachment)(nil), "blog.Attachment")
+	proto.RegisterType((*UploadAttachmentRequest)(nil), "blog.UploadAttachmentRequest")
+	proto.RegisterType((*UploadAttachmentResponse)(nil), "blog.UploadAttachmentResponse")
+	proto.RegisterType((*DownloadAttachmentRequest)(nil), "blog.DownloadAttachmentRequest")
+	proto.RegisterType((*DownloadAttachmentResponse)(nil), "blog.DownloadAttachmentResponse")
+}
+
+func init() { proto.RegisterFile("blog/blogpb/blog.proto", fileDescriptor_a4b0406114889fe6) }
+
+var fileDescriptor_a4b0406114889fe6 = []byte{
+	// 2220 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4f, 0x53, 0xe3, 0xc8,
+	0x15, 0x47, 0xfe, 0x87, 0xfd, 0x6c, 0xc0, 0x6e, 0x58, 0x10, 0xda, 0xcc, 0x2c, 0xe9, 0xdd, 0xcc,
+	0x92, 0x4a, 0x16, 0x36, 0xcc, 0xce, 0x24, 0x5b, 0xb3, 0x93, 0xac, 0x07, 0x9b, 0xc1, 0x19, 0x60,
+	0x29, 0x01, 0xb3, 0x55, 0xc9, 0x81, 0x12, 0x56, 0x1b, 0xab, 0x56, 0xb6, 0xbc, 0x92, 0x4c, 0x60,
+	0x4e, 0xb9, 0xe4, 0x98, 0x43, 0x6e, 0xb9, 0xe5, 0x83, 0xe4, 0x94, 0xaf, 0x91, 0x7c, 0x99, 0x54,
+	0xff, 0x93, 0x5a, 0x92, 0x8d, 0xed, 0xaa, 0xbd, 0xcc, 0xf8, 0xfd, 0xe9, 0xf7, 0x5e, 0xff, 0xfa,
+	0xe9, 0xf5, 0xeb, 0x07, 0x6c, 0xde, 0xb8, 0xde, 0xed, 0x3e, 0xfd, 0x67, 0x74, 0xc3, 0xfe, 0xdb,
+	0x1b, 0xf9, 0x5e, 0xe8, 0xa1, 0x02, 0xfd, 0x6d, 0xec, 0xdc, 0x7a, 0xde, 0xad, 0x4b, 0xf6, 0x19,
+	0xef, 0x66, 0xdc, 0xdb, 0xef, 0x39, 0xc4, 0xb5, 0xaf, 0x07, 0x56, 0xf0, 0x03, 0xd7, 0x33, 0x3e,
+	0x49, 0x6b, 0x84, 0xce, 0x80, 0x04, 0xa1, 0x35, 0x18, 0x71, 0x05, 0xfc, 0x00, 0xa5, 0xe6, 0x38,
+	0xec, 0x7b, 0x3e, 0x5a, 0x85, 0x9c, 0x63, 0xeb, 0xda, 0x8e, 0xb6, 0x5b, 0x31, 0x73, 0x8e, 0x8d,
+	0x10, 0x14, 0x86, 0xd6, 0x80, 0xe8, 0x39, 0xc6, 0x61, 0xbf, 0x51, 0x1d, 0xf2, 0x37, 0x8e, 0xa7,
+	0xe7, 0x19, 0x8b, 0xfe, 0x44, 0x5f, 0x03, 0x74, 0x7d, 0x62, 0x85, 0xc4, 0xbe, 0xb6, 0x42, 0xbd,
+	0xb0, 0xa3, 0xed, 0x56, 0x0f, 0x8c, 0x3d, 0xee, 0x75, 0x4f, 0x7a, 0xdd, 0xbb, 0x94, 0x5e, 0xcd,
+	0x8a, 0xd0, 0x6e, 0x86, 0xf8, 0xbf, 0x79, 0x28, 0xbc, 0x71, 0xbd, 0xdb, 0x8c, 0xe7, 0x8f, 0xa1,
+	0x62, 0xb1, 0x98, 0xae, 0x1d, 0x5b, 0xb8, 0x2f, 0x73, 0x46, 0xc7, 0x46, 0x1b, 0x50, 0x0c, 0x9d,
+	0xd0, 0x25, 0x22, 0x08, 0x4e, 0x20, 0x1d, 0x96, 0xbb, 0xde, 0x30, 0x24, 0x43, 0x1e, 0x43, 0xc5,
+	0x94, 0x24, 0x95, 0xdc, 0x11, 0x3f, 0x70, 0xbc, 0xa1, 0x5e, 0xdc, 0xd1, 0x76, 0xf3, 0xa6, 0x24,
+	0xd1, 0xef, 0x60, 0x55, 0x28, 0x5d, 0xf7, 0x3c, 0x7f, 0x60, 0x85, 0x7a, 0x69, 0x47, 0xdb, 0x5d,
+	0x3d, 0x68, 0xec, 0x31, 0xa0, 0x69, 0x68, 0x7b, 0x47, 0x4c, 0x60, 0xae, 0x08, 0x45, 0x4e, 0xa2,
+	0x5f, 0x42, 0x29, 0x08, 0xad, 0x70, 0x1c, 0xe8, 0xcb, 0x99, 0x15, 0x17, 0x4c, 0x60, 0x0a, 0x05,
+	0x8a, 0xcf, 0x68, 0x7c, 0xe3, 0x3a, 0x41, 0x9f, 0xe2, 0x53, 0x9e, 0x8d, 0x8f, 0xd0, 0x6e, 0x86,
+	0xf4, 0x00, 0x42, 0xeb, 0x36, 0xd0, 0x2b, 0x3b, 0x79, 0x7a, 0x00, 0xf4, 0x37, 0x35, 0x67, 0x13,
+	0x97, 0x08, 0xb8, 0x61, 0xb6, 0x39, 0xa1, 0xdd, 0x0c, 0xf1, 0xaf, 0xa0, 0x24, 0xc2, 0xaf, 0x40,
+	0xf1, 0xfc, 0xa4, 0xd9, 0x39, 0xab, 0x2f, 0xa1, 0x1a, 0x94, 0x4f, 0x9b, 0xe6, 0xbb, 0xd6, 0x77,
+	0xdf, 0x9f, 0xd5, 0x35, 0x54, 0x86, 0xc2, 0xf1, 0xe5, 0xe9, 0x49, 0x3d, 0x87, 0xff, 0x00, 0x25,
+	0xbe, 0x11, 0xaa, 0xdc, 0x32, 0x9b, 0x47, 0x97, 0xf5, 0x25, 0xb4, 0x02, 0x95, 0x8b, 0xc3, 0xe3,
+	0x76, 0xeb, 0xea, 0xa4, 0xdd, 0xaa, 0x6b, 0x94, 0x3c, 0xbf, 0x7a, 0x73, 0xd2, 0xb9, 0x38, 0x6e,
+	0xb7, 0xea, 0x39, 0x6a, 0xaa, 0x69, 0x1e, 0x1e, 0x77, 0xde, 0xb7, 0x5b, 0xf5, 0x3c, 0x7e, 0x0e,
+	0x8d, 0x43, 0x76, 0xd2, 0x14, 0x14, 0x93, 0xfc, 0x38, 0x26, 0x41, 0x88, 0x9e, 0x02, 0xcb, 0x5b,
+	0x76, 0xd4, 0xd5, 0x03, 0x88, 0x51, 0x33, 0x19, 0x1f, 0x7f, 0x05, 0x48, 0x5d, 0x14, 0x8c, 0xbc,
+	0x61, 0x40, 0x66, 0xae, 0xea, 0xc2, 0x9a, 0x49, 0x2c, 0x5b, 0x75, 0xb4, 0x05, 0xcb, 0x54, 0x74,
+	0x1d, 0xa5, 0x55, 0x89, 0x92, 0x1d, 0x1b, 0xfd, 0x16, 0x2a, 0x3e, 0xb1, 0xf8, 0x27, 0xa2, 0xe7,
+	0xa6, 0xc0, 0x77, 0x44, 0xbf, 0xa2, 0x53, 0x2b, 0xf8, 0xc1, 0x2c, 0x53, 0x65, 0xfa, 0x0b, 0x1f,
+	0x40, 0x3d, 0x76, 0x32, 0x67, 0x60, 0x7f, 0xd7, 0xa0, 0x71, 0x35, 0xb2, 0x17, 0x03, 0x81, 0x66,
+	0x3f, 0xb1, 0x9d, 0x30, 0x91, 0xfd, 0x9c, 0xd1, 0xb1, 0xd1, 0x2b, 0xa8, 0x8e, 0x99, 0x45, 0xbe,
+	0x83, 0xfc, 0xcc, 0x1d, 0x00, 0x57, 0x67, 0x7b, 0xf8, 0x0a, 0x90, 0x1a, 0xce, 0x9c, 0xbb, 0x38,
+	0x82, 0x46, 0x8b, 0x25, 0xd1, 0x5c, 0x00, 0x2b, 0x9f, 0x5b, 0x2e, 0xf1, 0xb9, 0xe1, 0x2f, 0x00,
+	0xa9, 0x76, 0x84, 0xf7, 0x69, 0x86, 0xa8, 0xba, 0x49, 0x82, 0xd0, 0xf3, 0xe7, 0xf2, 0x8b, 0x5f,
+	0xc0, 0x7a, 0x42, 0x7d, 0xce, 0xcd, 0xed, 0x43, 0xfd, 0xc4, 0x09, 0xc2, 0x4b, 0xdf, 0x0a, 0xfa,
+	0xd2, 0x47, 0xa2, 0xfc, 0x68, 0xc9, 0xf2, 0x83, 0x5f, 0x40, 0x43, 0x59, 0x20, 0xbc, 0xec, 0x40,
+	0x91, 0x5a, 0x0b, 0x74, 0x6d, 0x27, 0x9f, 0x72, 0xc3, 0x05, 0xf8, 0x00, 0xca, 0x97, 0xd6, 0xed,
+	0xa1, 0x37, 0x1e, 0x86, 0xb4, 0x88, 0x86, 0xd6, 0xad, 0xb0, 0x4c, 0x7f, 0xd2, 0x9a, 0xd6, 0xa5,
+	0x22, 0x01, 0x19, 0x27, 0xf0, 0xb7, 0xb0, 0xc6, 0x5c, 0x59, 0xb7, 0x81, 0x0c, 0xed, 0x0b, 0x28,
+	0xf3, 0xba, 0x42, 0xb8, 0xaf, 0x89, 0xa5, 0x27, 0x52, 0xc1, 0x2f, 0xa1, 0x1e, 0x5b, 0x10, 0xb1,
+	0x62, 0x51, 0x55, 0x78, 0xa8, 0xab, 0x7c, 0xb9, 0x8c, 0x8d, 0x57, 0x19, 0xfc, 0xb7, 0x1c, 0x77,
+	0xad, 0x22, 0xff, 0x18, 0x2a, 0x54, 0x38, 0xb2, 0x6e, 0xc9, 0x75, 0xe0, 0x7c, 0xe0, 0x17, 0x46,
+	0xd1, 0x2c, 0x53, 0xc6, 0x85, 0xf3, 0x81, 0xa0, 0x4d, 0x28, 0x75, 0xc7, 0x7e, 0xe0, 0xf9, 0xa2,
+	0x64, 0x0b, 0x2a, 0xb1, 0x99, 0xc2, 0xcc, 0xcd, 0x44, 0xe5, 0xb0, 0xa8, 0x94, 0xc3, 0xcf, 0x60,
+	0x75, 0x60, 0x85, 0xdd, 0xfe, 0xb5, 0xe5, 0xba, 0xd7, 0x4c, 0x4a, 0x4b, 0x78, 0xd9, 0xac, 0x31,
+	0x6e, 0xd3, 0x75, 0xe9, 0xd6, 0x93, 0x1f, 0xfd, 0xf2, 0x02, 0x1f, 0xfd, 0x3f, 0x35, 0x0e, 0xe0,
+	0x22, 0x29, 0xa5, 0x6c, 0x37, 0x97, 0xd8, 0xee, 0x67, 0x50, 0xe2, 0x78, 0x89, 0xaf, 0xb6, 0xc6,
+	0x57, 0xf2, 0xdb, 0xd7, 0x14, 0x32, 0xf4, 0x0c, 0x4a, 0x3d, 0xab, 0x4b, 0x42, 0x0e, 0x49, 0xf6,
+	0x80, 0x84, 0x14, 0x3f, 0x40, 0xe3, 0x82, 0x58, 0x7e, 0xb7, 0xaf, 0x9e, 0xd1, 0x06, 0x14, 0x7f,
+	0x1c, 0x13, 0xff, 0x41, 0x9c, 0x0f, 0x27, 0x28, 0xd7, 0x75, 0x06, 0x4e, 0x28, 0x0e, 0x86, 0x13,
+	0x11, 0x9c, 0xf9, 0x47, 0xe1, 0x2c, 0x64, 0xe1, 0xc4, 0x37, 0x50, 0x57, 0x5d, 0x07, 0x63, 0x77,
+	0x76, 0x51, 0xdb, 0x80, 0x62, 0xd0, 0xf5, 0x7c, 0x9e, 0x1c, 0x9a, 0xc9, 0x09, 0x5a, 0x2c, 0x82,
+	0xa1, 0x33, 0x1a, 0x91, 0x50, 0xa4, 0x86, 0x24, 0xf1, 0x10, 0x50, 0xc2, 0x07, 0x87, 0xfe, 0x4b,
+	0x58, 0xf6, 0x99, 0x3f, 0x99, 0xbe, 0x9b, 0xdc, 0x51, 0x3a, 0x1c, 0x53, 0xaa, 0x29, 0x70, 0xe6,
+	0x1e, 0x85, 0xf3, 0xdf, 0x1a, 0xd4, 0xf8, 0xfa, 0x3b, 0x87, 0x35, 0x07, 0x53, 0x0b, 0x9c, 0x01,
+	0x65, 0x5f, 0x28, 0x89, 0xcf, 0x35, 0xa2, 0x23, 0x14, 0xf2, 0xf3, 0x94, 0xf6, 0x42, 0xaa, 0xb4,
+	0x27, 0x3b, 0xa9, 0xe2, 0x22, 0x9d, 0xd4, 0x73, 0xd0, 0xe3, 0x34, 0xe5, 0xb1, 0x04, 0x33, 0x2b,
+	0xe6, 0x29, 0x6c, 0x4f, 0x58, 0x14, 0x21, 0x5d, 0x91, 0xbb, 0x92, 0x58, 0x23, 0x65, 0x3b, 0x42,
+	0x64, 0xc6, 0x4a, 0xf8, 0x14, 0x36, 0xdf, 0x92, 0x84, 0xb5, 0x99, 0x77, 0xc5, 0x23, 0x50, 0xe2,
+	0x0e, 0x6c, 0x65, 0xcc, 0x89, 0xd8, 0xf6, 0x94, 0x65, 0x3c, 0xdf, 0x26, 0x85, 0x16, 0x9b, 0x22,
+	0xd0, 0x30, 0xc9, 0x1d, 0xf1, 0xc3, 0xb9, 0x2e, 0xb0, 0xc7, 0xce, 0x37, 0x71, 0x7e, 0xf9, 0xe4,
+	0xf9, 0xe1, 0x73, 0x40, 0xaa, 0x9b, 0x39, 0xab, 0xc5, 0x63, 0x18, 0x5c, 0xc0, 0xda, 0x7b, 0x7e,
+	0x79, 0x1e, 0x7a, 0xc3, 0x9e, 0xeb, 0x74, 0x1f, 0x09, 0xfb, 0x73, 0x58, 0xeb, 0x8e, 0x7d, 0x9f,
+	0x36, 0xb3, 0xc9, 0xfb, 0x77, 0x55, 0xb0, 0x85, 0x25, 0xfc, 0x8f, 0x1c, 0x54, 0xa8, 0xff, 0xf6,
+	0x1d, 0xed, 0x8e, 0x0d, 0x28, 0x07, 0x14, 0x91, 0x61, 0x97, 0x30, 0x83, 0x79, 0x33, 0xa2, 0xd1,
+	0x2e, 0x14, 0xc2, 0x87, 0x11, 0xff, 0x64, 0x57, 0x0f, 0x36, 0xe2, 0xd0, 0xd9, 0xd2, 0xbd, 0xcb,
+	0x87, 0x11, 0x31, 0x99, 0x86, 0x1a, 0x55, 0x3e, 0x11, 0x95, 0xdc, 0x7d, 0x61, 0xca, 0xee, 0x5f,
+	0x41, 0xd5, 0xeb, 0xb2, 0x00, 0xe7, 0x4c, 0x7a, 0x90, 0xea, 0xcd, 0x10, 0x1f, 0x43, 0x81, 0xc6,
+	0x80, 0xaa, 0xb0, 0x7c, 0x75, 0xf6, 0xee, 0x8c, 0xb6, 0xb0, 0x4b, 0x94, 0x38, 0x34, 0xdb, 0xcd,
+	0x4b, 0xd6, 0xa1, 0x52, 0xc9, 0x79, 0x8b, 0x11, 0x39, 0x4a, 0xb4, 0xda, 0x27, 0x6d, 0x4a, 0xe4,
+	0x69, 0xb3, 0x6a, 0xb6, 0x2f, 0x2e, 0xbf, 0x33, 0xdb, 0xad, 0x7a, 0x01, 0x5f, 0x41, 0xe3, 0x7b,
+	0x5a, 0xe1, 0x68, 0x64, 0xc1, 0x5c, 0x17, 0xde, 0xa7, 0xb0, 0xd2, 0xf3, 0xbd, 0xc1, 0x75, 0x04,
+	0x1e, 0x07, 0xbb, 0x46, 0x99, 0x17, 0x82, 0x87, 0x5f, 0x01, 0x52, 0xcd, 0x8a, 0x8c, 0xf8, 0x05,
+	0x14, 0x09, 0x05, 0x50, 0xa4, 0xc4, 0x5a, 0x0a, 0x57, 0x93, 0x4b, 0xf1, 0x7f, 0x34, 0x58, 0x3e,
+	0xf4, 0x06, 0x03, 0x7a, 0x4a, 0xe9, 0x07, 0x92, 0x82, 0x77, 0x2e, 0x81, 0x37, 0xbb, 0x87, 0x59,
+	0x12, 0xc4, 0x09, 0xca, 0x19, 0x9d, 0xd4, 0xb3, 0xaa, 0x90, 0xda, 0x90, 0xf2, 0x80, 0x2a, 0x26,
+	0x1f, 0x50, 0xc9, 0xba, 0x54, 0x5a, 0xa4, 0x2e, 0x7d, 0x03, 0x8d, 0xa6, 0x6d, 0x8b, 0x5d, 0x48,
+	0x5c, 0x3f, 0xa7, 0x9e, 0x18, 0x47, 0x20, 0xb0, 0xc2, 0x11, 0x90, 0x6a, 0x52, 0x8a, 0x5f, 0x03,
+	0x52, 0x57, 0x0b, 0xf8, 0xe6, 0x5e, 0xfe, 0x0e, 0xd6, 0x69, 0x7d, 0x13, 0xfc, 0x99, 0xf5, 0x30,
+	0x89, 0x5d, 0x2e, 0x89, 0x1d, 0xbe, 0x82, 0x8d, 0xa4, 0xb1, 0x05, 0xa3, 0xa1, 0x17, 0xa0, 0x4d,
+	0x46, 0x61, 0x5f, 0x5e, 0xc2, 0x8c, 0xc0, 0x67, 0xb0, 0xc1, 0x7b, 0xe2, 0x14, 0x46, 0x53, 0x83,
+	0x7c, 0x02, 0x20, 0x2c, 0xc6, 0x51, 0x56, 0x04, 0xa7, 0x63, 0xe3, 0x97, 0xf0, 0x51, 0xca, 0x9e,
+	0x88, 0x33, 0xb9, 0x4e, 0x4b, 0xaf, 0x7b, 0x05, 0xeb, 0xfc, 0xe1, 0x25, 0xba, 0x11, 0x11, 0x46,
+	0xdc, 0xb2, 0x68, 0xd3, 0x5b, 0x16, 0xfc, 0x0d, 0x6c, 0x24, 0x17, 0x0b, 0x9f, 0xf3, 0xad, 0xde,
+	0x87, 0xfa, 0x5b, 0x12, 0x26, 0xfd, 0x3e, 0xda, 0x81, 0x7f, 0x0d, 0x0d, 0x65, 0xc1, 0x42, 0xbe,
+	0x36, 0x00, 0xd1, 0x53, 0xe4, 0x5c, 0x99, 0x11, 0xf8, 0x35, 0xac, 0x27, 0xb8, 0xc2, 0xe4, 0x33,
+	0x58, 0xe6, 0xcb, 0xe4, 0x05, 0x98, 0xb4, 0x29, 0x85, 0xf4, 0x55, 0xd5, 0x19, 0x8c, 0x3c, 0x5e,
+	0xf7, 0x83, 0x79, 0x9f, 0xba, 0x97, 0xb0, 0xc2, 0x57, 0x1d, 0x59, 0x8e, 0x3b, 0xf6, 0x09, 0x4d,
+	0x10, 0x67, 0x68, 0x93, 0x7b, 0xb6, 0xa2, 0x68, 0x72, 0x62, 0xfa, 0x97, 0xbe, 0x01, 0x45, 0xe2,
+	0xfb, 0x51, 0x4f, 0xcd, 0x09, 0xfc, 0x01, 0xd6, 0x13, 0xb1, 0x88, 0xad, 0x18, 0x50, 0x76, 0x18,
+	0x9b, 0xd8, 0xc2, 0x7c, 0x44, 0xd3, 0x76, 0xb5, 0x67, 0x39, 0x2e, 0xb1, 0x45, 0x66, 0x0a, 0x0a,
+	0xed, 0x43, 0xb9, 0xc7, 0x43, 0xe3, 0x3d, 0x62, 0xf5, 0x60, 0x9d, 0x6f, 0x22, 0x11, 0xb6, 0x19,
+	0x29, 0xe1, 0xdf, 0x00, 0x6a, 0xdf, 0x67, 0x70, 0x98, 0xf1, 0x98, 0x5a, 0x6f, 0xdf, 0x67, 0xc3,
+	0x9d, 0x85, 0xdd, 0xaf, 0xe9, 0x85, 0x3e, 0xb4, 0x89, 0x3f, 0xd7, 0xcb, 0x70, 0x0c, 0x48, 0xd5,
+	0x9e, 0xf1, 0xee, 0x9c, 0xfe, 0x80, 0xa5, 0x1d, 0x73, 0x3f, 0x1c, 0xb8, 0x02, 0x71, 0xf6, 0x9b,
+	0x6a, 0x93, 0xfb, 0x2e, 0xf1, 0x47, 0xd1, 0xdc, 0x49, 0x90, 0xf8, 0x5f, 0x1a, 0xa0, 0x73, 0x3e,
+	0xcb, 0x99, 0xab, 0xef, 0x48, 0x0e, 0x8a, 0x72, 0x8b, 0x0c, 0x8a, 0x94, 0x90, 0xf3, 0xc9, 0x90,
+	0x1f, 0x6b, 0x38, 0x29, 0xfa, 0x89, 0x00, 0xe7, 0x44, 0xff, 0x7f, 0x1a, 0x40, 0x33, 0x0c, 0xad,
+	0x6e, 0x7f, 0xb1, 0xbb, 0xc9, 0x80, 0x72, 0xcf, 0x71, 0x09, 0x9b, 0x29, 0x8a, 0xab, 0x49, 0xd2,
+	0xe8, 0xe7, 0x50, 0x93, 0xa3, 0x38, 0xd6, 0x72, 0xf0, 0x50, 0xab, 0x82, 0xc7, 0x6e, 0x79, 0x04,
+	0x05, 0xf6, 0xba, 0xe4, 0x43, 0x3c, 0xf6, 0x9b, 0xe6, 0x6e, 0xd0, 0xb7, 0x0e, 0x5e, 0xbc, 0x64,
+	0xd7, 0x52, 0xc5, 0x14, 0x54, 0xea, 0xca, 0x5a, 0x5e, 0xe4, 0xca, 0xb2, 0x60, 0xeb, 0x6a, 0xe4,
+	0x7a, 0x96, 0x1d, 0x6f, 0x51, 0x1e, 0xdd, 0x33, 0x28, 0x38, 0xc3, 0x9e, 0x27, 0x80, 0xa9, 0x8b,
+	0x6a, 0x10, 0xa9, 0x1d, 0x2f, 0x99, 0x4c, 0x8e, 0x36, 0xa1, 0xd8, 0xed, 0x8f, 0x87, 0x7c, 0xbe,
+	0x54, 0x3b, 0x5e, 0x32, 0x39, 0xf9, 0xa6, 0x04, 0x05, 0xdb, 0x0a, 0x2d, 0x7c, 0x02, 0x7a, 0xd6,
+	0x45, 0xd4, 0x77, 0x83, 0x15, 0x71, 0xa7, 0x79, 0x32, 0x15, 0x1d, 0xfc, 0x2d, 0x6c, 0xb7, 0xbc,
+	0xbf, 0x0c, 0x27, 0x87, 0xfc, 0x29, 0xac, 0xc4, 0xaa, 0x71, 0xce, 0xd5, 0x62, 0x66, 0xc7, 0xc6,
+	0x36, 0x18, 0x93, 0x2c, 0x44, 0x65, 0xf0, 0x27, 0xd9, 0xf5, 0xc1, 0x5f, 0x57, 0xa1, 0x4a, 0xb3,
+	0xe8, 0x82, 0xf8, 0x77, 0x4e, 0x97, 0xa0, 0x26, 0x40, 0x3c, 0xeb, 0x43, 0x5b, 0xe2, 0xda, 0x4c,
+	0x8f, 0x0c, 0x0d, 0x3d, 0x2b, 0xe0, 0x81, 0xe1, 0x25, 0xf4, 0x0a, 0xca, 0x72, 0x26, 0x87, 0x3e,
+	0xe2, 0x7a, 0xa9, 0x41, 0xa0, 0xb1, 0x99, 0x66, 0x47, 0x8b, 0x9b, 0x00, 0xf1, 0x30, 0x4c, 0xfa,
+	0xcf, 0x4c, 0xeb, 0x0c, 0x3d, 0x2b, 0x50, 0x4d, 0xc4, 0x13, 0x2d, 0x69, 0x22, 0x33, 0x2b, 0x33,
+	0xf4, 0xac, 0x20, 0x32, 0xd1, 0x82, 0xaa, 0x32, 0xb6, 0x42, 0xba, 0x0c, 0x37, 0x3d, 0xf8, 0x32,
+	0xb6, 0x27, 0x48, 0x22, 0x2b, 0xbf, 0x87, 0x4a, 0x34, 0x94, 0x42, 0x62, 0xcb, 0xe9, 0xb1, 0x96,
+	0xb1, 0x95, 0xe1, 0x47, 0xeb, 0x5f, 0x43, 0x59, 0x3e, 0x05, 0x25, 0x90, 0xa9, 0xf1, 0x8f, 0xb1,
+	0x99, 0x66, 0xcb, 0xc5, 0x5f, 0x6a, 0x14, 0x87, 0xf8, 0x05, 0x2e, 0x71, 0xc8, 0x4c, 0x27, 0x0c,
+	0x3d, 0x2b, 0x88, 0x22, 0x78, 0xcf, 0xc7, 0x6a, 0x89, 0xc7, 0x28, 0x7a, 0x9a, 0xf6, 0x99, 0x7c,
+	0xda, 0x1a, 0x9f, 0x4c, 0x95, 0x47, 0x76, 0xcf, 0x61, 0x2d, 0xf5, 0x8c, 0x44, 0x3f, 0xe3, 0xab,
+	0x26, 0x3f, 0x56, 0x8d, 0x27, 0x53, 0xa4, 0xea, 0xa1, 0xc7, 0xcf, 0x3c, 0xb9, 0xd9, 0xcc, 0xfb,
+	0xd2, 0xd0, 0xb3, 0x82, 0xc8, 0xc4, 0x21, 0x40, 0xfc, 0x2e, 0x90, 0x26, 0x32, 0x0f, 0x10, 0x43,
+	0xcf, 0x0a, 0x92, 0xa0, 0xc7, 0xdd, 0xb1, 0x34, 0x92, 0xe9, 0xb6, 0x0d, 0x3d, 0x2b, 0x88, 0xe2,
+	0xe8, 0x40, 0x4d, 0x6d, 0x6a, 0xd1, 0x76, 0x8c, 0x67, 0xaa, 0x6b, 0x36, 0x8c, 0x49, 0x22, 0x25,
+	0x9a, 0x3f, 0xc2, 0x4a, 0xa2, 0xf1, 0x44, 0x86, 0x9a, 0xf4, 0xa9, 0x98, 0x3e, 0x9e, 0x28, 0x8b,
+	0xc2, 0x7a, 0x0b, 0x35, 0xb5, 0x9f, 0x94, 0x61, 0x4d, 0x68, 0x50, 0x0d, 0x63, 0x92, 0x48, 0xfd,
+	0x2c, 0xa2, 0x4e, 0x51, 0x7e, 0x16, 0xe9, 0x5e, 0xd3, 0xd8, 0xca, 0xf0, 0xd5, 0x8f, 0x53, 0x69,
+	0x0c, 0xe5, 0xc7, 0x99, 0xed, 0x20, 0x8d, 0xed, 0x09, 0x92, 0xc8, 0xca, 0x11, 0x54, 0x95, 0x9e,
+	0x4c, 0x5a, 0xc9, 0xb6, 0x8c, 0xc6, 0xf6, 0x04, 0x89, 0xb4, 0xb2, 0xab, 0x51, 0x3b, 0xed, 0xfb,
+	0x8c, 0x9d, 0xf6, 0xfd, 0x34, 0x3b, 0x13, 0x3a, 0x2b, 0x99, 0x38, 0x71, 0x3f, 0x14, 0x27, 0x70,
+	0xaa, 0x9f, 0x32, 0xf4, 0xac, 0x40, 0x05, 0x46, 0xe9, 0x1c, 0x64, 0x28, 0xd9, 0x6e, 0xc7, 0xd8,
+	0x9e, 0x20, 0x51, 0xcb, 0xb7, 0x9c, 0x4e, 0xab, 0x55, 0x47, 0x99, 0x77, 0x1b, 0x9b, 0x69, 0x76,
+	0xb4, 0xf8, 0x0a, 0xea, 0xe9, 0x4b, 0x14, 0x3d, 0x91, 0xb5, 0x7a, 0xe2, 0x65, 0x68, 0x3c, 0x9d,
+	0x26, 0x56, 0x40, 0xfe, 0x33, 0xa0, 0xec, 0x5d, 0x88, 0x44, 0xa1, 0x99, 0x7a, 0xcf, 0x1a, 0x3b,
+	0xd3, 0x15, 0x62, 0xe4, 0xdf, 0x94, 0xff, 0x54, 0xe2, 0x7f, 0xc9, 0xbd, 0x29, 0xb1, 0x26, 0xe4,
+	0xf9, 0xff, 0x07, 0x00, 0xbd, 0x2c, 0x4c, 0x24, 0xdf, 0x1d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BlogServiceClient is the client API for BlogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlogServiceClient interface {
+	// return FAILED_PRECONDITION if the author does not exist
+	// return INVALID_ARGUMENT with a BadRequest detail if the blog is invalid
+	CreateBlog(ctx context.Context, in *CreateBlogRequest, opts ...grpc.CallOption) (*CreateBlogResponse, error)
+	// return NOT_FOUND if not found
+	// return INVALID_ARGUMENT if the read mask lists an unknown field
+	ReadBlog(ctx context.Context, in *ReadBlogRequest, opts ...grpc.CallOption) (*ReadBlogResponse, error)
+	// return NOT_FOUND if not found
+	// return FAILED_PRECONDITION if the author does not exist
+	// return ABORTED with a VersionConflict detail if the version is stale
+	// return INVALID_ARGUMENT if the update mask lists an unknown field
+	// return INVALID_ARGUMENT with a BadRequest detail if the blog is invalid
+	UpdateBlog(ctx context.Context, in *UpdateBlogRequest, opts ...grpc.CallOption) (*UpdateBlogResponse, error)
+	// move a blog to the trash, it is purged after the retention period
+	// return NOT_FOUND if not found
+	// return ABORTED with a VersionConflict detail if the version is stale
+	DeleteBlog(ctx context.Context, in *DeleteBlogRequest, opts ...grpc.CallOption) (*DeleteBlogResponse, error)
+	// take a deleted blog out of the trash
+	// return NOT_FOUND if the blog is not in the trash
+	RestoreBlog(ctx context.Context, in *RestoreBlogRequest, opts ...grpc.CallOption) (*RestoreBlogResponse, error)
+	// list the deleted blogs that were not purged yet
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	// stream one page of blogs ordered by id, the cursor of the next page is sent
+	// in the "next-cursor" trailer and is empty after the last page
+	// return INVALID_ARGUMENT if the read mask lists an unknown field
+	ListBlog(ctx context.Context, in *ListBlogRequest, opts ...grpc.CallOption) (BlogService_ListBlogClient, error)
+	// full-text search over the titles and contents of published blogs
+	// return INVALID_ARGUMENT if the query has no searchable word
+	SearchBlog(ctx context.Context, in *SearchBlogRequest, opts ...grpc.CallOption) (*SearchBlogResponse, error)
+	// return NOT_FOUND if the blog is not found
+	ListBlogRevisions(ctx context.Context, in *ListBlogRevisionsRequest, opts ...grpc.CallOption) (*ListBlogRevisionsResponse, error)
+	// return NOT_FOUND if the blog or the revision is not found
+	GetBlogRevision(ctx context.Context, in *GetBlogRevisionRequest, opts ...grpc.CallOption) (*GetBlogRevisionResponse, error)
+	// restore the content of an old revision as a new revision
+	// return NOT_FOUND if the blog or the revision is not found
+	RevertBlog(ctx context.Context, in *RevertBlogRequest, opts ...grpc.CallOption) (*RevertBlogResponse, error)
+	// stream changes to blogs as they happen until the client cancels
+	// return OUT_OF_RANGE if from_sequence is too old to be resumed
+	WatchBlogs(ctx context.Context, in *WatchBlogsRequest, opts ...grpc.CallOption) (BlogService_WatchBlogsClient, error)
+	// return NOT_FOUND if the blog or the parent comment is not found
+	AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*AddCommentResponse, error)
+	// stream the discussion depth first, every comment followed by its
+	// replies, oldest first
+	// return NOT_FOUND if the blog or the parent comment is not found
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (BlogService_ListCommentsClient, error)
+	// delete a comment and all its replies
+	// return NOT_FOUND if not found
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	// return ALREADY_EXISTS if the id is taken
+	CreateAuthor(ctx context.Context, in *CreateAuthorRequest, opts ...grpc.CallOption) (*CreateAuthorResponse, error)
+	// return NOT_FOUND if not found
+	GetAuthor(ctx context.Context, in *GetAuthorRequest, opts ...grpc.CallOption) (*GetAuthorResponse, error)
+	ListAuthors(ctx context.Context, in *ListAuthorsRequest, opts ...grpc.CallOption) (*ListAuthorsResponse, error)
+	// create every blog sent by the client, blogs that cannot be created
+	// are reported in the response instead of failing the whole import
+	ImportBlogs(ctx context.Context, opts ...grpc.CallOption) (BlogService_ImportBlogsClient, error)
+	// stream every blog ordered by id
+	ExportBlogs(ctx context.Context, in *ExportBlogsRequest, opts ...grpc.CallOption) (BlogService_ExportBlogsClient, error)
+	// render the content of a blog according to its format
+	// return NOT_FOUND if not found
+	RenderBlog(ctx context.Context, in *RenderBlogRequest, opts ...grpc.CallOption) (*RenderBlogResponse, error)
+	// publish a blog now or schedule it to be published later
+	// return NOT_FOUND if not found
+	// return ABORTED with a VersionConflict detail if the version is stale
+	PublishBlog(ctx context.Context, in *PublishBlogRequest, opts ...grpc.CallOption) (*PublishBlogResponse, error)
+	// count how many blogs use each tag
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// store a file attached to a blog, sent in chunks after a header
+	// return NOT_FOUND if the blog is not found
+	// return DATA_LOSS if the content does not match its checksum
+	// return RESOURCE_EXHAUSTED if the file is too big
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (BlogService_UploadAttachmentClient, error)
+	// stream the header and then the content of an attachment
+	// return NOT_FOUND if not found
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (BlogService_DownloadAttachmentClient, error)
+}
+
+type blogServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBlogServiceClient(cc *grpc.ClientConn) BlogServiceClient {
+	return &blogServiceClient{cc}
+}
+
+func (c *blogServiceClient) CreateBlog(ctx context.Context, in *CreateBlogRequest, opts ...grpc.CallOption) (*CreateBlogResponse, error) {
+	out := new(CreateBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/CreateBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ReadBlog(ctx context.Context, in *ReadBlogRequest, opts ...grpc.CallOption) (*ReadBlogResponse, error) {
+	out := new(ReadBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/ReadBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) UpdateBlog(ctx context.Context, in *UpdateBlogRequest, opts ...grpc.CallOption) (*UpdateBlogResponse, error) {
+	out := new(UpdateBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/UpdateBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) DeleteBlog(ctx context.Context, in *DeleteBlogRequest, opts ...grpc.CallOption) (*DeleteBlogResponse, error) {
+	out := new(DeleteBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/DeleteBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) RestoreBlog(ctx context.Context, in *RestoreBlogRequest, opts ...grpc.CallOption) (*RestoreBlogResponse, error) {
+	out := new(RestoreBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/RestoreBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/ListTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListBlog(ctx context.Context, in *ListBlogRequest, opts ...grpc.CallOption) (BlogService_ListBlogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[0], "/blog.BlogService/ListBlog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceListBlogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogService_ListBlogClient interface {
+	Recv() (*ListBlogResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceListBlogClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceListBlogClient) Recv() (*ListBlogResponse, error) {
+	m := new(ListBlogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) SearchBlog(ctx context.Context, in *SearchBlogRequest, opts ...grpc.CallOption) (*SearchBlogResponse, error) {
+	out := new(SearchBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/SearchBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListBlogRevisions(ctx context.Context, in *ListBlogRevisionsRequest, opts ...grpc.CallOption) (*ListBlogRevisionsResponse, error) {
+	out := new(ListBlogRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/ListBlogRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) GetBlogRevision(ctx context.Context, in *GetBlogRevisionRequest, opts ...grpc.CallOption) (*GetBlogRevisionResponse, error) {
+	out := new(GetBlogRevisionResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/GetBlogRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) RevertBlog(ctx context.Context, in *RevertBlogRequest, opts ...grpc.CallOption) (*RevertBlogResponse, error) {
+	out := new(RevertBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/RevertBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) WatchBlogs(ctx context.Context, in *WatchBlogsRequest, opts ...grpc.CallOption) (BlogService_WatchBlogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[1], "/blog.BlogService/WatchBlogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceWatchBlogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogService_WatchBlogsClient interface {
+	Recv() (*WatchBlogsResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceWatchBlogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceWatchBlogsClient) Recv() (*WatchBlogsResponse, error) {
+	m := new(WatchBlogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*AddCommentResponse, error) {
+	out := new(AddCommentResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/AddComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (BlogService_ListCommentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[2], "/blog.BlogService/ListComments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceListCommentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogService_ListCommentsClient interface {
+	Recv() (*ListCommentsResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceListCommentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceListCommentsClient) Recv() (*ListCommentsResponse, error) {
+	m := new(ListCommentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) CreateAuthor(ctx context.Context, in *CreateAuthorRequest, opts ...grpc.CallOption) (*CreateAuthorResponse, error) {
+	out := new(CreateAuthorResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/CreateAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) GetAuthor(ctx context.Context, in *GetAuthorRequest, opts ...grpc.CallOption) (*GetAuthorResponse, error) {
+	out := new(GetAuthorResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/GetAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListAuthors(ctx context.Context, in *ListAuthorsRequest, opts ...grpc.CallOption) (*ListAuthorsResponse, error) {
+	out := new(ListAuthorsResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/ListAuthors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ImportBlogs(ctx context.Context, opts ...grpc.CallOption) (BlogService_ImportBlogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[3], "/blog.BlogService/ImportBlogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceImportBlogsClient{stream}
+	return x, nil
+}
+
+type BlogService_ImportBlogsClient interface {
+	Send(*ImportBlogsRequest) error
+	CloseAndRecv() (*ImportBlogsResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceImportBlogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceImportBlogsClient) Send(m *ImportBlogsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *blogServiceImportBlogsClient) CloseAndRecv() (*ImportBlogsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportBlogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) ExportBlogs(ctx context.Context, in *ExportBlogsRequest, opts ...grpc.CallOption) (BlogService_ExportBlogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[4], "/blog.BlogService/ExportBlogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceExportBlogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogService_ExportBlogsClient interface {
+	Recv() (*ExportBlogsResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceExportBlogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceExportBlogsClient) Recv() (*ExportBlogsResponse, error) {
+	m := new(ExportBlogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) RenderBlog(ctx context.Context, in *RenderBlogRequest, opts ...grpc.CallOption) (*RenderBlogResponse, error) {
+	out := new(RenderBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/RenderBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) PublishBlog(ctx context.Context, in *PublishBlogRequest, opts ...grpc.CallOption) (*PublishBlogResponse, error) {
+	out := new(PublishBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/PublishBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (BlogService_UploadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[5], "/blog.BlogService/UploadAttachment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceUploadAttachmentClient{stream}
+	return x, nil
+}
+
+type BlogService_UploadAttachmentClient interface {
+	Send(*UploadAttachmentRequest) error
+	CloseAndRecv() (*UploadAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceUploadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceUploadAttachmentClient) Send(m *UploadAttachmentRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *blogServiceUploadAttachmentClient) CloseAndRecv() (*UploadAttachmentResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (BlogService_DownloadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[6], "/blog.BlogService/DownloadAttachment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceDownloadAttachmentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogService_DownloadAttachmentClient interface {
+	Recv() (*DownloadAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceDownloadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceDownloadAttachmentClient) Recv() (*DownloadAttachmentResponse, error) {
+	m := new(DownloadAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BlogServiceServer is the server API for BlogService service.
+type BlogServiceServer interface {
+	// return FAILED_PRECONDITION if the author does not exist
+	// return INVALID_ARGUMENT with a BadRequest detail if the blog is invalid
+	CreateBlog(context.Context, *CreateBlogRequest) (*CreateBlogResponse, error)
+	// return NOT_FOUND if not found
+	// return INVALID_ARGUMENT if the read mask lists an unknown field
+	ReadBlog(context.Context, *ReadBlogRequest) (*ReadBlogResponse, error)
+	// return NOT_FOUND if not found
+	// return FAILED_PRECONDITION if the author does not exist
+	// return ABORTED with a VersionConflict detail if the version is stale
+	// return INVALID_ARGUMENT if the update mask lists an unknown field
+	// return INVALID_ARGUMENT with a BadRequest detail if the blog is invalid
+	UpdateBlog(context.Context, *UpdateBlogRequest) (*UpdateBlogResponse, error)
+	// move a blog to the trash, it is purged after the retention period
+	// return NOT_FOUND if not found
+	// return ABORTED with a VersionConflict detail if the version is stale
+	DeleteBlog(context.Context, *DeleteBlogRequest) (*DeleteBlogResponse, error)
+	// take a deleted blog out of the trash
+	// return NOT_FOUND if the blog is not in the trash
+	RestoreBlog(context.Context, *RestoreBlogRequest) (*RestoreBlogResponse, error)
+	// list the deleted blogs that were not purged yet
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	// stream one page of blogs ordered by id, the cursor of the next page is sent
+	// in the "next-cursor" trailer and is empty after the last page
+	// return INVALID_ARGUMENT if the read mask lists an unknown field
+	ListBlog(*ListBlogRequest, BlogService_ListBlogServer) error
+	// full-text search over the titles and contents of published blogs
+	// return INVALID_ARGUMENT if the query has no searchable word
+	SearchBlog(context.Context, *SearchBlogRequest) (*SearchBlogResponse, error)
+	// return NOT_FOUND if the blog is not found
+	ListBlogRevisions(context.Context, *ListBlogRevisionsRequest) (*ListBlogRevisionsResponse, error)
+	// return NOT_FOUND if the blog or the revision is not found
+	GetBlogRevision(context.Context, *GetBlogRevisionRequest) (*GetBlogRevisionResponse, error)
+	// restore the content of an old revision as a new revision
+	// return NOT_FOUND if the blog or the revision is not found
+	RevertBlog(context.Context, *RevertBlogRequest) (*RevertBlogResponse, error)
+	// stream changes to blogs as they happen until the client cancels
+	// return OUT_OF_RANGE if from_sequence is too old to be resumed
+	WatchBlogs(*WatchBlogsRequest, BlogService_WatchBlogsServer) error
+	// return NOT_FOUND if the blog or the parent comment is not found
+	AddComment(context.Context, *AddCommentRequest) (*AddCommentResponse, error)
+	// stream the discussion depth first, every comment followed by its
+	// replies, oldest first
+	// return NOT_FOUND if the blog or the parent comment is not found
+	ListComments(*ListCommentsRequest, BlogService_ListCommentsServer) error
+	// delete a comment and all its replies
+	// return NOT_FOUND if not found
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	// return ALREADY_EXISTS if the id is taken
+	CreateAuthor(context.Context, *CreateAuthorRequest) (*CreateAuthorResponse, error)
+	// return NOT_FOUND if not found
+	GetAuthor(context.Context, *GetAuthorRequest) (*GetAuthorResponse, error)
+	ListAuthors(context.Context, *ListAuthorsRequest) (*ListAuthorsResponse, error)
+	// create every blog sent by the client, blogs that cannot be created
+	// are reported in the response instead of failing the whole import
+	ImportBlogs(BlogService_ImportBlogsServer) error
+	// stream every blog ordered by id
+	ExportBlogs(*ExportBlogsRequest, BlogService_ExportBlogsServer) error
+	// render the content of a blog according to its format
+	// return NOT_FOUND if not found
+	RenderBlog(context.Context, *RenderBlogRequest) (*RenderBlogResponse, error)
+	// publish a blog now or schedule it to be published later
+	// return NOT_FOUND if not found
+	// return ABORTED with a VersionConflict detail if the version is stale
+	PublishBlog(context.Context, *PublishBlogRequest) (*PublishBlogResponse, error)
+	// count how many blogs use each tag
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// store a file attached to a blog, sent in chunks after a header
+	// return NOT_FOUND if the blog is not found
+	// return DATA_LOSS if the content does not match its checksum
+	// return RESOURCE_EXHAUSTED if the file is too big
+	UploadAttachment(BlogService_UploadAttachmentServer) error
+	// stream the header and then the content of an attachment
+	// return NOT_FOUND if not found
+	DownloadAttachment(*DownloadAttachmentRequest, BlogService_DownloadAttachmentServer) error
+}
+
+// UnimplementedBlogServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedBlogServiceServer struct {
+}
+
+func (*UnimplementedBlogServiceServer) CreateBlog(ctx context.Context, req *CreateBlogRequest) (*CreateBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBlog not implemented")
+}
+func (*UnimplementedBlogServiceServer) ReadBlog(ctx context.Context, req *ReadBlogRequest) (*ReadBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadBlog not implemented")
+}
+func (*UnimplementedBlogServiceServer) UpdateBlog(ctx context.Context, req *UpdateBlogRequest) (*UpdateBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlog not implemented")
+}
+func (*UnimplementedBlogServiceServer) DeleteBlog(ctx context.Context, req *DeleteBlogRequest) (*DeleteBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBlog not implemented")
+}
+func (*UnimplementedBlogServiceServer) RestoreBlog(ctx context.Context, req *RestoreBlogRequest) (*RestoreBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBlog not implemented")
+}
+func (*UnimplementedBlogServiceServer) ListTrash(ctx context.Context, req *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (*UnimplementedBlogServiceServer) ListBlog(req *ListBlogRequest, srv BlogService_ListBlogServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBlog not implemented")
+}
+func (*UnimplementedBlogServiceServer) SearchBlog(ctx context.Context, req *SearchBlogRequest) (*SearchBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBlog not implemented")
+}
+func (*UnimplementedBlogServiceServer) ListBlogRevisions(ctx context.Context, req *ListBlogRevisionsRequest) (*ListBlogRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlogRevisions not implemented")
+}
+func (*UnimplementedBlogServiceServer) GetBlogRevision(ctx context.Context, req *GetBlogRevisionRequest) (*GetBlogRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlogRevision not implemented")
+}
+func (*UnimplementedBlogServiceServer) RevertBlog(ctx context.Context, req *RevertBlogRequest) (*RevertBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertBlog not implemented")
+}
+func (*UnimplementedBlogServiceServer) WatchBlogs(req *WatchBlogsRequest, srv BlogService_WatchBlogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBlogs not implemented")
+}
+func (*UnimplementedBlogServiceServer) AddComment(ctx context.Context, req *AddCommentRequest) (*AddCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
+}
+func (*UnimplementedBlogServiceServer) ListComments(req *ListCommentsRequest, srv BlogService_ListCommentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (*UnimplementedBlogServiceServer) DeleteComment(ctx context.Context, req *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (*UnimplementedBlogServiceServer) CreateAuthor(ctx context.Context, req *CreateAuthorRequest) (*CreateAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuthor not implemented")
+}
+func (*UnimplementedBlogServiceServer) GetAuthor(ctx context.Context, req *GetAuthorRequest) (*GetAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthor not implemented")
+}
+func (*UnimplementedBlogServiceServer) ListAuthors(ctx context.Context, req *ListAuthorsRequest) (*ListAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthors not implemented")
+}
+func (*UnimplementedBlogServiceServer) ImportBlogs(srv BlogService_ImportBlogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportBlogs not implemented")
+}
+func (*UnimplementedBlogServiceServer) ExportBlogs(req *ExportBlogsRequest, srv BlogService_ExportBlogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportBlogs not implemented")
+}
+func (*UnimplementedBlogServiceServer) RenderBlog(ctx context.Context, req *RenderBlogRequest) (*RenderBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderBlog not implemented")
+}
+func (*UnimplementedBlogServiceServer) PublishBlog(ctx context.Context, req *PublishBlogRequest) (*PublishBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishBlog not implemented")
+}
+func (*UnimplementedBlogServiceServer) ListTags(ctx context.Context, req *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (*UnimplementedBlogServiceServer) UploadAttachment(srv BlogService_UploadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (*UnimplementedBlogServiceServer) DownloadAttachment(req *DownloadAttachmentRequest, srv BlogService_DownloadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+
+func RegisterBlogServiceServer(s *grpc.Server, srv BlogServiceServer) {
+	s.RegisterService(&_BlogService_serviceDesc, srv)
+}
+
+func _BlogService_CreateBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).CreateBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/CreateBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).CreateBlog(ctx, req.(*CreateBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ReadBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ReadBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/ReadBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ReadBlog(ctx, req.(*ReadBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_UpdateBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).UpdateBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/UpdateBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).UpdateBlog(ctx, req.(*UpdateBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_DeleteBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).DeleteBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/DeleteBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).DeleteBlog(ctx, req.(*DeleteBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_RestoreBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RestoreBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/RestoreBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RestoreBlog(ctx, req.(*RestoreBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/ListTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListBlog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBlogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogServiceServer).ListBlog(m, &blogServiceListBlogServer{stream})
+}
+
+type BlogService_ListBlogServer interface {
+	Send(*ListBlogResponse) error
+	grpc.ServerStream
+}
+
+type blogServiceListBlogServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceListBlogServer) Send(m *ListBlogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlogService_SearchBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).SearchBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/SearchBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).SearchBlog(ctx, req.(*SearchBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListBlogRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlogRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListBlogRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/ListBlogRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListBlogRevisions(ctx, req.(*ListBlogRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetBlogRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlogRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetBlogRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/GetBlogRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetBlogRevision(ctx, req.(*GetBlogRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_RevertBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RevertBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/RevertBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RevertBlog(ctx, req.(*RevertBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_WatchBlogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBlogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogServiceServer).WatchBlogs(m, &blogServiceWatchBlogsServer{stream})
+}
+
+type BlogService_WatchBlogsServer interface {
+	Send(*WatchBlogsResponse) error
+	grpc.ServerStream
+}
+
+type blogServiceWatchBlogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceWatchBlogsServer) Send(m *WatchBlogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlogService_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).AddComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/AddComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).AddComment(ctx, req.(*AddCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListComments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCommentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogServiceServer).ListComments(m, &blogServiceListCommentsServer{stream})
+}
+
+type BlogService_ListCommentsServer interface {
+	Send(*ListCommentsResponse) error
+	grpc.ServerStream
+}
+
+type blogServiceListCommentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceListCommentsServer) Send(m *ListCommentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlogService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/DeleteComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_CreateAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).CreateAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/CreateAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).CreateAuthor(ctx, req.(*CreateAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/GetAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetAuthor(ctx, req.(*GetAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/ListAuthors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListAuthors(ctx, req.(*ListAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ImportBlogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BlogServiceServer).ImportBlogs(&blogServiceImportBlogsServer{stream})
+}
+
+type BlogService_ImportBlogsServer interface {
+	SendAndClose(*ImportBlogsResponse) error
+	Recv() (*ImportBlogsRequest, error)
+	grpc.ServerStream
+}
+
+type blogServiceImportBlogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceImportBlogsServer) SendAndClose(m *ImportBlogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *blogServiceImportBlogsServer) Recv() (*ImportBlogsRequest, error) {
+	m := new(ImportBlogsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BlogService_ExportBlogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportBlogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogServiceServer).ExportBlogs(m, &blogServiceExportBlogsServer{stream})
+}
+
+type BlogService_ExportBlogsServer interface {
+	Send(*ExportBlogsResponse) error
+	grpc.ServerStream
+}
+
+type blogServiceExportBlogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceExportBlogsServer) Send(m *ExportBlogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlogService_RenderBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RenderBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/RenderBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RenderBlog(ctx, req.(*RenderBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_PublishBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).PublishBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/PublishBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).PublishBlog(ctx, req.(*PublishBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BlogServiceServer).UploadAttachment(&blogServiceUploadAttachmentServer{stream})
+}
+
+type BlogService_UploadAttachmentServer interface {
+	SendAndClose(*UploadAttachmentResponse) error
+	Recv() (*UploadAttachmentRequest, error)
+	grpc.ServerStream
+}
+
+type blogServiceUploadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceUploadAttachmentServer) SendAndClose(m *UploadAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *blogServiceUploadAttachmentServer) Recv() (*UploadAttachmentRequest, error) {
+	m := new(UploadAttachmentRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BlogService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogServiceServer).DownloadAttachment(m, &blogServiceDownloadAttachmentServer{stream})
+}
+
+type BlogService_DownloadAttachmentServer interface {
+	Send(*DownloadAttachmentResponse) error
+	grpc.ServerStream
+}
+
+type blogServiceDownloadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceDownloadAttachmentServer) Send(m *DownloadAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
 var _BlogService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "blog.BlogService",
 	HandlerType: (*BlogServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "blog/blogpb/blog.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBlog",
+			Handler:    _BlogService_CreateBlog_Handler,
+		},
+		{
+			MethodName: "ReadBlog",
+			Handler:    _BlogService_ReadBlog_Handler,
+		},
+		{
+			MethodName: "UpdateBlog",
+			Handler:    _BlogService_UpdateBlog_Handler,
+		},
+		{
+			MethodName: "DeleteBlog",
+			Handler:    _BlogService_DeleteBlog_Handler,
+		},
+		{
+			MethodName: "RestoreBlog",
+			Handler:    _BlogService_RestoreBlog_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _BlogService_ListTrash_Handler,
+		},
+		{
+			MethodName: "SearchBlog",
+			Handler:    _BlogService_SearchBlog_Handler,
+		},
+		{
+			MethodName: "ListBlogRevisions",
+			Handler:    _BlogService_ListBlogRevisions_Handler,
+		},
+		{
+			MethodName: "GetBlogRevision",
+			Handler:    _BlogService_GetBlogRevision_Handler,
+		},
+		{
+			MethodName: "RevertBlog",
+			Handler:    _BlogService_RevertBlog_Handler,
+		},
+		{
+			MethodName: "AddComment",
+			Handler:    _BlogService_AddComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _BlogService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "CreateAuthor",
+			Handler:    _BlogService_CreateAuthor_Handler,
+		},
+		{
+			MethodName: "GetAuthor",
+			Handler:    _BlogService_GetAuthor_Handler,
+		},
+		{
+			MethodName: "ListAuthors",
+			Handler:    _BlogService_ListAuthors_Handler,
+		},
+		{
+			MethodName: "RenderBlog",
+			Handler:    _BlogService_RenderBlog_Handler,
+		},
+		{
+			MethodName: "PublishBlog",
+			Handler:    _BlogService_PublishBlog_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _BlogService_ListTags_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListBlog",
+			Handler:       _BlogService_ListBlog_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchBlogs",
+			Handler:       _BlogService_WatchBlogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListComments",
+			Handler:       _BlogService_ListComments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportBlogs",
+			Handler:       _BlogService_ImportBlogs_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportBlogs",
+			Handler:       _BlogService_ExportBlogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _BlogService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _BlogService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "blog/blogpb/blog.proto",
 }
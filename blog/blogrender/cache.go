@@ -0,0 +1,82 @@
+package blogrender
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"sync"
+
+	"grpc-go-course/blog/blogpb"
+)
+
+// cacheKey identifies a revision of a blog. The id and version alone are
+// not enough: a purged blog can come back under the same id, starting over
+// from version 1, so the key also holds a hash of what gets rendered.
+type cacheKey struct {
+	blogID  string
+	version int64
+	sum     [sha256.Size]byte
+}
+
+// keyOf returns the cache key of blog
+func keyOf(blog *blogpb.Blog) cacheKey {
+	h := sha256.New()
+	h.Write([]byte(blog.GetContentFormat().String()))
+	h.Write([]byte{0})
+	h.Write([]byte(blog.GetContent()))
+	key := cacheKey{blogID: blog.GetId(), version: blog.GetVersion()}
+	h.Sum(key.sum[:0])
+	return key
+}
+
+type cacheEntry struct {
+	key      cacheKey
+	rendered Rendered
+}
+
+// Cache keeps the most recently rendered blog revisions.
+// It is safe for concurrent use.
+type Cache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[cacheKey]*list.Element
+	// recent holds the entries, most recently used first
+	recent *list.List
+}
+
+// NewCache returns a cache holding at most size rendered revisions
+func NewCache(size int) *Cache {
+	return &Cache{
+		size:    size,
+		entries: make(map[cacheKey]*list.Element),
+		recent:  list.New(),
+	}
+}
+
+// Render returns the rendered content of blog, rendering it only if this
+// version of the blog is not cached yet
+func (c *Cache) Render(blog *blogpb.Blog) Rendered {
+	key := keyOf(blog)
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		c.recent.MoveToFront(e)
+		rendered := e.Value.(*cacheEntry).rendered
+		c.mu.Unlock()
+		return rendered
+	}
+	c.mu.Unlock()
+
+	rendered := Render(blog)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		c.entries[key] = c.recent.PushFront(&cacheEntry{key: key, rendered: rendered})
+		for c.recent.Len() > c.size {
+			oldest := c.recent.Back()
+			c.recent.Remove(oldest)
+			delete(c.entries, oldest.Value.(*cacheEntry).key)
+		}
+	}
+	return rendered
+}
@@ -0,0 +1,75 @@
+package blogrender
+
+import (
+	"testing"
+
+	"grpc-go-course/blog/blogpb"
+)
+
+func TestCacheRender(t *testing.T) {
+	blog := &blogpb.Blog{
+		Id:            "blog",
+		Version:       1,
+		Content:       "# Title",
+		ContentFormat: blogpb.Blog_MARKDOWN,
+	}
+	// a blog purged and created again under the same id and version
+	recreated := &blogpb.Blog{
+		Id:            "blog",
+		Version:       1,
+		Content:       "other content",
+		ContentFormat: blogpb.Blog_MARKDOWN,
+	}
+
+	tests := []struct {
+		name        string
+		blogs       []*blogpb.Blog
+		wantEntries int
+	}{
+		{
+			name:        "same revision twice",
+			blogs:       []*blogpb.Blog{blog, blog},
+			wantEntries: 1,
+		},
+		{
+			name:        "same id and version with another content",
+			blogs:       []*blogpb.Blog{blog, recreated},
+			wantEntries: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCache(10)
+			for _, b := range tt.blogs {
+				if got, want := c.Render(b), Render(b); got != want {
+					t.Errorf("Render(%q) = %+v, want %+v", b.GetContent(), got, want)
+				}
+			}
+			if got := c.recent.Len(); got != tt.wantEntries {
+				t.Errorf("cache holds %v entries, want %v", got, tt.wantEntries)
+			}
+		})
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewCache(2)
+	blogs := make([]*blogpb.Blog, 3)
+	for i := range blogs {
+		blogs[i] = &blogpb.Blog{Id: "blog", Version: int64(i + 1), Content: "content"}
+	}
+
+	c.Render(blogs[0])
+	c.Render(blogs[1])
+	// using the first revision again makes the second the oldest
+	c.Render(blogs[0])
+	c.Render(blogs[2])
+
+	if _, ok := c.entries[keyOf(blogs[0])]; !ok {
+		t.Error("recently used revision was evicted")
+	}
+	if _, ok := c.entries[keyOf(blogs[1])]; ok {
+		t.Error("least recently used revision is still cached")
+	}
+}
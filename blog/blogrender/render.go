@@ -0,0 +1,90 @@
+package blogrender
+
+import (
+	"html"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/russross/blackfriday/v2"
+	"grpc-go-course/blog/blogpb"
+)
+
+// excerptLength is the maximum number of characters of an excerpt
+const excerptLength = 200
+
+var (
+	// ugcPolicy keeps the formatting of user generated content but drops
+	// scripts, styles, event handlers and unsafe links
+	ugcPolicy = bluemonday.UGCPolicy()
+
+	// textPolicy drops every tag
+	textPolicy = bluemonday.StrictPolicy()
+)
+
+// Rendered is the content of a blog ready to be displayed
+type Rendered struct {
+	HTML    string
+	Excerpt string
+}
+
+// Render turns the content of a blog into sanitized HTML according to its
+// format, and extracts a plain text excerpt from it
+func Render(blog *blogpb.Blog) Rendered {
+	var unsafe string
+	switch blog.GetContentFormat() {
+	case blogpb.Blog_MARKDOWN:
+		unsafe = string(blackfriday.Run([]byte(blog.GetContent())))
+	case blogpb.Blog_HTML:
+		unsafe = blog.GetContent()
+	default:
+		unsafe = plainToHTML(blog.GetContent())
+	}
+
+	safe := ugcPolicy.Sanitize(unsafe)
+	return Rendered{
+		HTML:    safe,
+		Excerpt: excerpt(safe),
+	}
+}
+
+// plainToHTML escapes text and keeps its paragraphs and line breaks
+func plainToHTML(text string) string {
+	text = strings.Replace(text, "\r\n", "\n", -1)
+	var b strings.Builder
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+		lines := strings.Split(paragraph, "\n")
+		for i, line := range lines {
+			lines[i] = html.EscapeString(line)
+		}
+		b.WriteString("<p>")
+		b.WriteString(strings.Join(lines, "<br>\n"))
+		b.WriteString("</p>\n")
+	}
+	return b.String()
+}
+
+// excerpt returns the beginning of the text of an HTML document, cut on a
+// word boundary
+func excerpt(doc string) string {
+	// keep words of adjacent blocks apart once the tags are gone
+	doc = strings.Replace(doc, "<", " <", -1)
+	text := html.UnescapeString(textPolicy.Sanitize(doc))
+	words := strings.Fields(text)
+
+	var b strings.Builder
+	for _, word := range words {
+		if b.Len()+len(word)+1 > excerptLength {
+			b.WriteString("...")
+			break
+		}
+		if b.Len() > 0 {
+			b.WriteString(" ")
+		}
+		b.WriteString(word)
+	}
+	return b.String()
+}
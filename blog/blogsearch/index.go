@@ -0,0 +1,133 @@
+package blogsearch
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"grpc-go-course/blog/blogpb"
+)
+
+// titleBoost is how much more a term found in the title counts than
+// the same term found in the content
+const titleBoost = 3
+
+// Hit is a blog matching a search with its score
+type Hit struct {
+	BlogID string
+	Score  float64
+}
+
+// Index is an in-memory inverted index over the title and content of blogs.
+// It is safe for concurrent use.
+type Index struct {
+	mu sync.RWMutex
+	// postings maps a term to the weighted frequency of that term in each blog
+	postings map[string]map[string]int
+	// docs keeps the terms of each blog so it can be removed from postings
+	docs map[string][]string
+}
+
+// NewIndex returns an empty index
+func NewIndex() *Index {
+	return &Index{
+		postings: make(map[string]map[string]int),
+		docs:     make(map[string][]string),
+	}
+}
+
+// Add indexes a blog, replacing any previous version of it
+func (idx *Index) Add(blog *blogpb.Blog) {
+	freqs := make(map[string]int)
+	for _, term := range terms(blog.GetTitle()) {
+		freqs[term] += titleBoost
+	}
+	for _, term := range terms(blog.GetContent()) {
+		freqs[term]++
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(blog.GetId())
+	docTerms := make([]string, 0, len(freqs))
+	for term, freq := range freqs {
+		posting, ok := idx.postings[term]
+		if !ok {
+			posting = make(map[string]int)
+			idx.postings[term] = posting
+		}
+		posting[blog.GetId()] = freq
+		docTerms = append(docTerms, term)
+	}
+	idx.docs[blog.GetId()] = docTerms
+}
+
+// Remove drops a blog from the index
+func (idx *Index) Remove(blogID string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(blogID)
+}
+
+func (idx *Index) remove(blogID string) {
+	for _, term := range idx.docs[blogID] {
+		posting := idx.postings[term]
+		delete(posting, blogID)
+		if len(posting) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	delete(idx.docs, blogID)
+}
+
+// Search returns the blogs matching any term of query, best match first.
+// Blogs are ranked by term frequency weighted by how rare each term is.
+func (idx *Index) Search(query string) []Hit {
+	queryTerms := uniqueTerms(query)
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	total := float64(len(idx.docs))
+	scores := make(map[string]float64)
+	for _, term := range queryTerms {
+		posting := idx.postings[term]
+		if len(posting) == 0 {
+			continue
+		}
+		idf := math.Log(1 + total/float64(len(posting)))
+		for blogID, freq := range posting {
+			scores[blogID] += float64(freq) * idf
+		}
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for blogID, score := range scores {
+		hits = append(hits, Hit{BlogID: blogID, Score: score})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].BlogID < hits[j].BlogID
+	})
+	return hits
+}
+
+// uniqueTerms returns the distinct terms of text
+func uniqueTerms(text string) []string {
+	seen := make(map[string]bool)
+	var result []string
+	for _, term := range terms(text) {
+		if !seen[term] {
+			seen[term] = true
+			result = append(result, term)
+		}
+	}
+	return result
+}
+
+// Searchable reports whether query contains at least one indexed word
+func Searchable(query string) bool {
+	return len(terms(query)) > 0
+}
@@ -0,0 +1,68 @@
+package blogsearch
+
+import (
+	"html"
+	"strings"
+)
+
+const (
+	// snippetWords is how many words are kept around the first match
+	snippetWords = 12
+
+	highlightStart = "<em>"
+	highlightEnd   = "</em>"
+)
+
+// Snippet returns an HTML excerpt of text around the first word matching
+// query, with every matching word wrapped in <em> tags.
+// The beginning of text is used when nothing matches.
+func Snippet(text string, query string) string {
+	tokens := tokenize(text)
+	if len(tokens) == 0 {
+		return ""
+	}
+	wanted := make(map[string]bool)
+	for _, term := range terms(query) {
+		wanted[term] = true
+	}
+
+	first := 0
+	for i, t := range tokens {
+		if wanted[t.term] {
+			first = i
+			break
+		}
+	}
+
+	from := first - snippetWords/2
+	if from < 0 {
+		from = 0
+	}
+	to := from + snippetWords
+	if to > len(tokens) {
+		to = len(tokens)
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("... ")
+	}
+	pos := tokens[from].start
+	for _, t := range tokens[from:to] {
+		b.WriteString(html.EscapeString(text[pos:t.start]))
+		if wanted[t.term] {
+			b.WriteString(highlightStart)
+			b.WriteString(html.EscapeString(text[t.start:t.end]))
+			b.WriteString(highlightEnd)
+		} else {
+			b.WriteString(html.EscapeString(text[t.start:t.end]))
+		}
+		pos = t.end
+	}
+	if to < len(tokens) {
+		b.WriteString(" ...")
+	} else {
+		b.WriteString(html.EscapeString(text[pos:]))
+	}
+	return b.String()
+}
@@ -0,0 +1,101 @@
+package blogsearch
+
+import (
+	"strings"
+	"unicode"
+)
+
+// token is a word of a text with its position in bytes
+type token struct {
+	term  string
+	start int
+	end   int
+}
+
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "by": true, "for": true, "from": true, "in": true, "is": true,
+	"it": true, "of": true, "on": true, "or": true, "that": true, "the": true,
+	"this": true, "to": true, "was": true, "with": true,
+}
+
+// suffixes are tried in order, the first one that leaves a long enough
+// stem is removed
+var suffixes = []struct {
+	suffix      string
+	replacement string
+}{
+	{"ational", "ate"},
+	{"ization", "ize"},
+	{"fulness", "ful"},
+	{"ousness", "ous"},
+	{"iveness", "ive"},
+	{"ements", ""},
+	{"ement", ""},
+	{"ments", ""},
+	{"ment", ""},
+	{"ness", ""},
+	{"ings", ""},
+	{"ing", ""},
+	{"edly", ""},
+	{"ies", "y"},
+	{"ied", "y"},
+	{"ed", ""},
+	{"ly", ""},
+	{"es", ""},
+	{"s", ""},
+}
+
+// minStemLength prevents suffix stripping from destroying short words
+const minStemLength = 3
+
+// stem is a very small suffix stripper. It is not a real stemmer but it
+// makes "posts", "posted" and "posting" all match "post".
+func stem(word string) string {
+	for _, s := range suffixes {
+		if strings.HasSuffix(word, s.suffix) && len(word)-len(s.suffix) >= minStemLength {
+			if strings.HasSuffix(word, "ss") && s.suffix == "s" {
+				return word
+			}
+			return word[:len(word)-len(s.suffix)] + s.replacement
+		}
+	}
+	return word
+}
+
+// tokenize splits text into lower case stemmed terms, skipping stop words
+func tokenize(text string) []token {
+	var tokens []token
+	start := -1
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		word := strings.ToLower(text[start:end])
+		if !stopWords[word] {
+			tokens = append(tokens, token{term: stem(word), start: start, end: end})
+		}
+		start = -1
+	}
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		flush(i)
+	}
+	flush(len(text))
+	return tokens
+}
+
+// terms returns only the terms of the tokens of text
+func terms(text string) []string {
+	tokens := tokenize(text)
+	result := make([]string, len(tokens))
+	for i, t := range tokens {
+		result[i] = t.term
+	}
+	return result
+}
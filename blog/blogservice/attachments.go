@@ -0,0 +1,156 @@
+package blogservice
+
+import (
+	"encoding/hex"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc-go-course/blog/blogattach"
+	"grpc-go-course/blog/blogpb"
+	"io"
+)
+
+const (
+	// maxAttachmentSize is the largest attachment accepted, in bytes
+	maxAttachmentSize = 10 << 20
+
+	// downloadChunkSize is the size of the chunks sent by DownloadAttachment
+	downloadChunkSize = 64 << 10
+)
+
+func (s *server) UploadAttachment(stream blogpb.BlogService_UploadAttachmentServer) error {
+
+	fmt.Println("Upload attachment request")
+	req, err := stream.Recv()
+	if err == io.EOF {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Missing attachment info in request",
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	info := req.GetInfo()
+	if info == nil {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"The first message must hold the attachment info",
+		)
+	}
+	if info.GetFilename() == "" {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Missing filename in attachment info",
+		)
+	}
+	if sum, err := hex.DecodeString(info.GetSha256()); err != nil || len(sum) != 32 {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"The attachment info needs the hex encoded SHA-256 of the content",
+		)
+	}
+	blogID := info.GetBlogId()
+	if _, err := s.store.Get(blogID); err != nil {
+		return storeError(err, blogID)
+	}
+
+	upload, err := s.attachments.Create(info)
+	if err != nil {
+		return attachmentError(err, "")
+	}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			// We have finished reading the client stream
+			break
+		}
+		if err != nil {
+			upload.Abort()
+			return err
+		}
+		if req.GetInfo() != nil {
+			upload.Abort()
+			return status.Errorf(
+				codes.InvalidArgument,
+				"Only the first message can hold the attachment info",
+			)
+		}
+		if _, err := upload.Write(req.GetChunk()); err != nil {
+			upload.Abort()
+			return attachmentError(err, "")
+		}
+	}
+
+	attachment, err := upload.Commit()
+	if err != nil {
+		return attachmentError(err, "")
+	}
+	return stream.SendAndClose(&blogpb.UploadAttachmentResponse{
+		Attachment: attachment,
+	})
+}
+
+func (s *server) DownloadAttachment(req *blogpb.DownloadAttachmentRequest, stream blogpb.BlogService_DownloadAttachmentServer) error {
+
+	fmt.Println("Download attachment request")
+	attachmentID := req.GetAttachmentId()
+	info, content, err := s.attachments.Get(attachmentID)
+	if err != nil {
+		return attachmentError(err, attachmentID)
+	}
+	defer content.Close()
+
+	sendErr := stream.Send(&blogpb.DownloadAttachmentResponse{
+		Data: &blogpb.DownloadAttachmentResponse_Info{Info: info},
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := content.Read(buf)
+		if n > 0 {
+			sendErr := stream.Send(&blogpb.DownloadAttachmentResponse{
+				Data: &blogpb.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return attachmentError(err, attachmentID)
+		}
+	}
+}
+
+// attachmentError converts an error returned by the attachment store into
+// a gRPC status
+func attachmentError(err error, attachmentID string) error {
+	switch err {
+	case blogattach.ErrNotFound:
+		return status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find attachment with specified ID: %v", attachmentID),
+		)
+	case blogattach.ErrTooLarge:
+		return status.Errorf(
+			codes.ResourceExhausted,
+			fmt.Sprintf("Attachments cannot be larger than %v bytes", maxAttachmentSize),
+		)
+	case blogattach.ErrChecksumMismatch:
+		return status.Errorf(
+			codes.DataLoss,
+			"The attachment does not match its SHA-256, upload it again",
+		)
+	}
+	return status.Errorf(
+		codes.Internal,
+		fmt.Sprintf("Internal error: %v", err),
+	)
+}
@@ -0,0 +1,82 @@
+package blogservice
+
+import (
+	"fmt"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc-go-course/blog/blogpb"
+	"reflect"
+	"strings"
+)
+
+// blogFields maps the name of every field of a blog, as written in
+// blog.proto, to the index of the matching field of blogpb.Blog
+var blogFields = protoFields(reflect.TypeOf(blogpb.Blog{}))
+
+// readOnlyFields can be listed in read masks but not in update masks. The
+// status and publish time only change through PublishBlog and the scheduler.
+var readOnlyFields = map[string]bool{
+	"id":         true,
+	"version":    true,
+	"status":     true,
+	"publish_at": true,
+	"deleted_at": true,
+}
+
+// protoFields reads the proto names of the fields of a generated message
+// struct from their tags
+func protoFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		for _, part := range strings.Split(t.Field(i).Tag.Get("protobuf"), ",") {
+			if strings.HasPrefix(part, "name=") {
+				fields[strings.TrimPrefix(part, "name=")] = i
+			}
+		}
+	}
+	return fields
+}
+
+// checkMask returns an INVALID_ARGUMENT status if mask lists a field blogs
+// don't have or one of the forbidden fields. kind names the mask in the
+// error message.
+func checkMask(mask *field_mask.FieldMask, kind string, forbidden map[string]bool) error {
+	for _, path := range mask.GetPaths() {
+		if _, ok := blogFields[path]; !ok {
+			return status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Unknown blog field in %v mask: %q", kind, path),
+			)
+		}
+		if forbidden[path] {
+			return status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("The blog field %q cannot be listed in the %v mask", path, kind),
+			)
+		}
+	}
+	return nil
+}
+
+// projectBlog returns a blog with only the fields of blog listed in mask,
+// or blog itself if the mask is empty. The mask must have been checked.
+func projectBlog(blog *blogpb.Blog, mask *field_mask.FieldMask) *blogpb.Blog {
+	if len(mask.GetPaths()) == 0 {
+		return blog
+	}
+	result := &blogpb.Blog{}
+	mergeBlog(result, blog, mask)
+	return result
+}
+
+// mergeBlog copies the fields listed in mask from src to dst.
+// The mask must have been checked.
+func mergeBlog(dst *blogpb.Blog, src *blogpb.Blog, mask *field_mask.FieldMask) {
+	from := reflect.ValueOf(src).Elem()
+	to := reflect.ValueOf(dst).Elem()
+	for _, path := range mask.GetPaths() {
+		i := blogFields[path]
+		to.Field(i).Set(from.Field(i))
+	}
+}
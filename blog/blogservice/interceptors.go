@@ -0,0 +1,21 @@
+package blogservice
+
+// idempotentMethods are the RPCs changing blogs whose retries are made safe
+// by idempotency keys
+var idempotentMethods = []string{
+	"/blog.BlogService/CreateBlog",
+	"/blog.BlogService/UpdateBlog",
+	"/blog.BlogService/DeleteBlog",
+	"/blog.BlogService/RestoreBlog",
+	"/blog.BlogService/RevertBlog",
+	"/blog.BlogService/PublishBlog",
+	"/blog.BlogService/AddComment",
+	"/blog.BlogService/DeleteComment",
+	"/blog.BlogService/CreateAuthor",
+}
+
+// limitedMethods are the RPCs whose calls are rate limited
+var limitedMethods = append([]string{
+	"/blog.BlogService/ImportBlogs",
+	"/blog.BlogService/UploadAttachment",
+}, idempotentMethods...)
@@ -0,0 +1,157 @@
+package blogservice
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc-go-course/blog/blogpb"
+	"grpc-go-course/blog/blogstore"
+	"log"
+	"time"
+)
+
+const (
+	// schedulerEditor is recorded as the editor of the revisions created
+	// when scheduled blogs get published
+	schedulerEditor = "scheduler"
+
+	// maxSchedulerWait is the longest the scheduler sleeps between two
+	// looks at the scheduled blogs
+	maxSchedulerWait = time.Minute
+
+	// conflictRetryWait is how long the scheduler waits before trying again
+	// to publish a blog that was changed while it was publishing it
+	conflictRetryWait = time.Second
+)
+
+func (s *server) PublishBlog(ctx context.Context, req *blogpb.PublishBlogRequest) (*blogpb.PublishBlogResponse, error) {
+
+	fmt.Println("Publish blog request")
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing blog id in request",
+		)
+	}
+
+	blog, err := s.store.Get(blogID)
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+	if req.GetVersion() != 0 {
+		blog.Version = req.GetVersion()
+	}
+
+	now := time.Now()
+	publishAt := now
+	if req.GetPublishAt() != nil {
+		publishAt, err = ptypes.Timestamp(req.GetPublishAt())
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Invalid publish time: %v", err),
+			)
+		}
+	}
+
+	if publishAt.After(now) {
+		blog.Status = blogpb.Blog_SCHEDULED
+		blog.PublishAt = req.GetPublishAt()
+	} else {
+		blog.Status = blogpb.Blog_PUBLISHED
+		blog.PublishAt, _ = ptypes.TimestampProto(now)
+	}
+
+	editorID := req.GetEditorId()
+	if editorID == "" {
+		editorID = blog.GetAuthorId()
+	}
+
+	data, err := s.store.Update(blog, editorID)
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+
+	return &blogpb.PublishBlogResponse{
+		Blog: data,
+	}, nil
+}
+
+// runScheduler publishes scheduled blogs when their time comes, until stop
+// is closed
+func (s *server) runScheduler(stop <-chan struct{}) {
+	for {
+		wait := maxSchedulerWait
+		if next, ok := s.publishDue(time.Now()); ok {
+			if d := time.Until(next); d < wait {
+				wait = d
+			}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-s.wakeScheduler:
+			timer.Stop()
+		case <-stop:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// publishDue publishes the scheduled blogs whose time has come and returns
+// when the next scheduled blog must be published, if there is one
+func (s *server) publishDue(now time.Time) (time.Time, bool) {
+	blogs, err := s.store.List()
+	if err != nil {
+		log.Printf("Scheduler cannot list blogs: %v", err)
+		return now.Add(conflictRetryWait), true
+	}
+
+	var next time.Time
+	found := false
+	setNext := func(t time.Time) {
+		if !found || t.Before(next) {
+			next = t
+			found = true
+		}
+	}
+
+	for _, blog := range blogs {
+		if blog.GetStatus() != blogpb.Blog_SCHEDULED {
+			continue
+		}
+		publishAt, err := ptypes.Timestamp(blog.GetPublishAt())
+		if err != nil {
+			log.Printf("Blog %v has an invalid publish time: %v", blog.GetId(), err)
+			continue
+		}
+		if publishAt.After(now) {
+			setNext(publishAt)
+			continue
+		}
+
+		// the version makes sure an editor didn't unschedule the blog
+		// since it was listed
+		published := proto.Clone(blog).(*blogpb.Blog)
+		published.Status = blogpb.Blog_PUBLISHED
+		data, err := s.store.Update(published, schedulerEditor)
+		switch err.(type) {
+		case nil:
+			fmt.Printf("Published scheduled blog %v\n", data.GetId())
+		case *blogstore.ConflictError:
+			setNext(now.Add(conflictRetryWait))
+		default:
+			// tried again at the next regular look unless it was deleted
+			if err != blogstore.ErrNotFound {
+				log.Printf("Scheduler cannot publish blog %v: %v", blog.GetId(), err)
+			}
+		}
+	}
+	return next, found
+}
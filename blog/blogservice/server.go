@@ -0,0 +1,894 @@
+package blogservice
+
+import (
+	"encoding/base64"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"grpc-go-course/blog/blogattach"
+	"grpc-go-course/blog/blogfeed"
+	"grpc-go-course/blog/blogpb"
+	"grpc-go-course/blog/blogrender"
+	"grpc-go-course/blog/blogsearch"
+	"grpc-go-course/blog/blogstore"
+	"grpc-go-course/blog/blogvalidate"
+	"io"
+)
+
+const (
+	// maxPageSize is the largest number of blogs sent by one ListBlog call
+	maxPageSize = 100
+
+	// nextCursorTrailer is the trailer holding the cursor of the next page
+	nextCursorTrailer = "next-cursor"
+
+	// renderCacheSize is how many rendered blog revisions are kept
+	renderCacheSize = 1000
+
+	// maxMergeAttempts is how many times a partial update without version
+	// is merged again when the blog keeps changing underneath it
+	maxMergeAttempts = 3
+
+	// maxIdempotencyKeys is how many idempotency keys are remembered at most
+	maxIdempotencyKeys = 10000
+)
+
+type server struct {
+	store blogstore.BlogStore
+	index *blogsearch.Index
+	feed  *blogfeed.Feed
+
+	renderCache *blogrender.Cache
+
+	attachments *blogattach.Store
+
+	// wakeScheduler tells the scheduler a blog may need publishing sooner
+	wakeScheduler chan struct{}
+}
+
+// newServer returns a server using store and attachments, and indexes the
+// blogs it holds
+func newServer(store blogstore.BlogStore, attachments *blogattach.Store) (*server, error) {
+	blogs, err := store.List()
+	if err != nil {
+		return nil, err
+	}
+	index := blogsearch.NewIndex()
+	for _, blog := range blogs {
+		index.Add(blog)
+	}
+	s := &server{
+		store: store,
+		index: index,
+		feed:  blogfeed.NewFeed(),
+
+		renderCache: blogrender.NewCache(renderCacheSize),
+
+		attachments: attachments,
+
+		wakeScheduler: make(chan struct{}, 1),
+	}
+	store.OnChange(s.changed)
+	return s, nil
+}
+
+// changed keeps the search index, the watchers and the scheduler up to
+// date with the changes of the store, which calls it in commit order
+func (s *server) changed(eventType blogpb.BlogEvent_Type, blog *blogpb.Blog) {
+	if eventType == blogpb.BlogEvent_DELETED {
+		s.index.Remove(blog.GetId())
+	} else {
+		s.index.Add(blog)
+	}
+	s.feed.Publish(eventType, blog)
+	if blog.GetStatus() == blogpb.Blog_SCHEDULED {
+		select {
+		case s.wakeScheduler <- struct{}{}:
+		default:
+			// the scheduler has already been woken up
+		}
+	}
+}
+
+func (s *server) CreateBlog(ctx context.Context, req *blogpb.CreateBlogRequest) (*blogpb.CreateBlogResponse, error) {
+
+	fmt.Println("Create blog request")
+	blog := req.GetBlog()
+	if blog == nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing blog in request",
+		)
+	}
+
+	data := &blogpb.Blog{
+		AuthorId:      blog.GetAuthorId(),
+		Title:         blog.GetTitle(),
+		Content:       blog.GetContent(),
+		ContentFormat: blog.GetContentFormat(),
+		// new blogs are drafts until PublishBlog publishes or schedules them
+		Status: blogpb.Blog_DRAFT,
+		Tags:   normalizeTags(blog.GetTags()),
+	}
+
+	data, err := s.store.Create(data)
+	if err != nil {
+		return nil, storeError(err, "")
+	}
+
+	return &blogpb.CreateBlogResponse{
+		Blog: data,
+	}, nil
+}
+
+func (s *server) ReadBlog(ctx context.Context, req *blogpb.ReadBlogRequest) (*blogpb.ReadBlogResponse, error) {
+
+	fmt.Println("Read blog request")
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing blog id in request",
+		)
+	}
+	if err := checkMask(req.GetReadMask(), "read", nil); err != nil {
+		return nil, err
+	}
+
+	data, err := s.store.Get(blogID)
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+
+	return &blogpb.ReadBlogResponse{
+		Blog: projectBlog(data, req.GetReadMask()),
+	}, nil
+}
+
+func (s *server) UpdateBlog(ctx context.Context, req *blogpb.UpdateBlogRequest) (*blogpb.UpdateBlogResponse, error) {
+
+	fmt.Println("Update blog request")
+	blog := req.GetBlog()
+	if blog.GetId() == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing blog id in request",
+		)
+	}
+
+	mask := req.GetUpdateMask()
+	if err := checkMask(mask, "update", readOnlyFields); err != nil {
+		return nil, err
+	}
+
+	changes := &blogpb.Blog{
+		Id:            blog.GetId(),
+		AuthorId:      blog.GetAuthorId(),
+		Title:         blog.GetTitle(),
+		Content:       blog.GetContent(),
+		Version:       blog.GetVersion(),
+		ContentFormat: blog.GetContentFormat(),
+		Tags:          normalizeTags(blog.GetTags()),
+	}
+
+	var data *blogpb.Blog
+	var err error
+	for attempt := 1; ; attempt++ {
+		data, err = s.applyUpdate(changes, mask, req.GetEditorId())
+		if _, ok := err.(*blogstore.ConflictError); !ok || changes.GetVersion() != 0 || attempt == maxMergeAttempts {
+			break
+		}
+		// the blog changed between reading and merging it, the client
+		// didn't ask for a version so merge into the new one
+	}
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, storeError(err, blog.GetId())
+	}
+
+	return &blogpb.UpdateBlogResponse{
+		Blog: data,
+	}, nil
+}
+
+// applyUpdate changes the fields of a blog listed in mask, or the whole
+// blog if the mask is empty. The status and publish time are always kept,
+// only PublishBlog and the scheduler change them. An update made without a
+// version is checked against the version it was merged into.
+func (s *server) applyUpdate(changes *blogpb.Blog, mask *field_mask.FieldMask, editorID string) (*blogpb.Blog, error) {
+	current, err := s.store.Get(changes.GetId())
+	if err != nil {
+		return nil, err
+	}
+	data := current
+	if len(mask.GetPaths()) > 0 {
+		mergeBlog(data, changes, mask)
+	} else {
+		data = proto.Clone(changes).(*blogpb.Blog)
+		data.Status = current.GetStatus()
+		data.PublishAt = current.GetPublishAt()
+	}
+	data.Version = current.GetVersion()
+	if changes.GetVersion() != 0 {
+		data.Version = changes.GetVersion()
+	}
+
+	if editorID == "" {
+		editorID = data.GetAuthorId()
+	}
+	return s.store.Update(data, editorID)
+}
+
+func (s *server) DeleteBlog(ctx context.Context, req *blogpb.DeleteBlogRequest) (*blogpb.DeleteBlogResponse, error) {
+
+	fmt.Println("Delete blog request")
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing blog id in request",
+		)
+	}
+
+	if _, err := s.store.Delete(blogID, req.GetVersion()); err != nil {
+		return nil, storeError(err, blogID)
+	}
+
+	return &blogpb.DeleteBlogResponse{
+		BlogId: blogID,
+	}, nil
+}
+
+func (s *server) ListBlog(req *blogpb.ListBlogRequest, stream blogpb.BlogService_ListBlogServer) error {
+
+	fmt.Println("List blog request")
+	pageSize := int(req.GetPageSize())
+	if pageSize < 0 {
+		return status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Received a negative page size: %v", pageSize),
+		)
+	}
+	if pageSize == 0 || pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	if err := checkMask(req.GetReadMask(), "read", nil); err != nil {
+		return err
+	}
+
+	after, err := decodeCursor(req.GetCursor())
+	if err != nil {
+		return status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Invalid cursor: %v", req.GetCursor()),
+		)
+	}
+
+	blogs, err := s.store.List()
+	if err != nil {
+		return storeError(err, "")
+	}
+
+	statuses := map[blogpb.Blog_Status]bool{
+		blogpb.Blog_PUBLISHED: len(req.GetStatuses()) == 0,
+	}
+	for _, st := range req.GetStatuses() {
+		statuses[st] = true
+	}
+	tags := normalizeTags(req.GetTags())
+
+	var matching []*blogpb.Blog
+	for _, blog := range blogs {
+		if req.GetAuthorId() != "" && blog.GetAuthorId() != req.GetAuthorId() {
+			continue
+		}
+		if !statuses[blog.GetStatus()] {
+			continue
+		}
+		if !matchTags(blog, tags, req.GetMatchAllTags()) {
+			continue
+		}
+		matching = append(matching, blog)
+	}
+
+	// blogs written before authors existed may not have a profile
+	authors := make(map[string]*blogpb.Author)
+
+	// blogs are ordered by id so the cursor is simply the last id sent
+	sent := 0
+	nextCursor := ""
+	for _, blog := range matching {
+		if blog.GetId() <= after {
+			continue
+		}
+		if sent == pageSize {
+			nextCursor = encodeCursor(after)
+			break
+		}
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		author, ok := authors[blog.GetAuthorId()]
+		if !ok {
+			author, err = s.store.GetAuthor(blog.GetAuthorId())
+			if err != nil && err != blogstore.ErrAuthorNotFound {
+				return storeError(err, blog.GetId())
+			}
+			authors[blog.GetAuthorId()] = author
+		}
+
+		after = blog.GetId()
+		res := &blogpb.ListBlogResponse{
+			Blog:   projectBlog(blog, req.GetReadMask()),
+			Cursor: encodeCursor(after),
+			Author: author,
+		}
+		if sent == 0 {
+			res.Facets = tagFacets(matching)
+		}
+		sendErr := stream.Send(res)
+		if sendErr != nil {
+			return sendErr
+		}
+		sent++
+	}
+
+	stream.SetTrailer(metadata.Pairs(nextCursorTrailer, nextCursor))
+	return nil
+}
+
+func (s *server) SearchBlog(ctx context.Context, req *blogpb.SearchBlogRequest) (*blogpb.SearchBlogResponse, error) {
+
+	fmt.Println("Search blog request")
+	query := req.GetQuery()
+	limit := int(req.GetLimit())
+	if limit < 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Received a negative limit: %v", limit),
+		)
+	}
+	if limit == 0 || limit > maxPageSize {
+		limit = maxPageSize
+	}
+	if !blogsearch.Searchable(query) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Query has no searchable word: %q", query),
+		)
+	}
+
+	tags := normalizeTags(req.GetTags())
+	var matching []*blogpb.Blog
+	res := &blogpb.SearchBlogResponse{}
+	for _, hit := range s.index.Search(query) {
+		blog, err := s.store.Get(hit.BlogID)
+		if err == blogstore.ErrNotFound {
+			// deleted since the search ran
+			continue
+		}
+		if err != nil {
+			return nil, storeError(err, hit.BlogID)
+		}
+		if blog.GetStatus() != blogpb.Blog_PUBLISHED {
+			continue
+		}
+		if !matchTags(blog, tags, req.GetMatchAllTags()) {
+			continue
+		}
+
+		// every match counts in the facets, even past the limit
+		matching = append(matching, blog)
+		if len(res.Results) == limit {
+			continue
+		}
+		snippet := blogsearch.Snippet(blog.GetContent(), query)
+		res.Results = append(res.Results, &blogpb.SearchBlogResult{
+			Blog:    blog,
+			Score:   hit.Score,
+			Snippet: snippet,
+		})
+	}
+	res.Facets = tagFacets(matching)
+	return res, nil
+}
+
+func (s *server) ListBlogRevisions(ctx context.Context, req *blogpb.ListBlogRevisionsRequest) (*blogpb.ListBlogRevisionsResponse, error) {
+
+	fmt.Println("List blog revisions request")
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing blog id in request",
+		)
+	}
+
+	revs, err := s.store.ListRevisions(blogID)
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+
+	return &blogpb.ListBlogRevisionsResponse{
+		Revisions: revs,
+	}, nil
+}
+
+func (s *server) GetBlogRevision(ctx context.Context, req *blogpb.GetBlogRevisionRequest) (*blogpb.GetBlogRevisionResponse, error) {
+
+	fmt.Println("Get blog revision request")
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing blog id in request",
+		)
+	}
+
+	rev, err := s.store.GetRevision(blogID, req.GetRevision())
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+
+	return &blogpb.GetBlogRevisionResponse{
+		Revision: rev,
+	}, nil
+}
+
+func (s *server) RevertBlog(ctx context.Context, req *blogpb.RevertBlogRequest) (*blogpb.RevertBlogResponse, error) {
+
+	fmt.Println("Revert blog request")
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing blog id in request",
+		)
+	}
+	if req.GetEditorId() == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing editor id in request",
+		)
+	}
+
+	rev, err := s.store.Revert(blogID, req.GetRevision(), req.GetEditorId())
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+
+	return &blogpb.RevertBlogResponse{
+		Blog:     rev.GetBlog(),
+		Revision: rev.GetRevision(),
+	}, nil
+}
+
+func (s *server) WatchBlogs(req *blogpb.WatchBlogsRequest, stream blogpb.BlogService_WatchBlogsServer) error {
+
+	fmt.Println("Watch blogs request")
+	backlog, watcher, err := s.feed.Subscribe(req.GetFromSequence())
+	switch err {
+	case nil:
+	case blogfeed.ErrSequenceExpired, blogfeed.ErrSequenceUnknown:
+		return status.Errorf(
+			codes.OutOfRange,
+			fmt.Sprintf("Cannot resume from sequence %v: %v", req.GetFromSequence(), err),
+		)
+	case blogfeed.ErrClosed:
+		return status.Errorf(
+			codes.Unavailable,
+			"The server is stopping",
+		)
+	default:
+		return status.Errorf(
+			codes.Internal,
+			fmt.Sprintf("Internal error: %v", err),
+		)
+	}
+	defer watcher.Close()
+
+	lastSequence := req.GetFromSequence()
+	send := func(event *blogpb.BlogEvent) error {
+		lastSequence = event.GetSequence()
+		if req.GetAuthorId() != "" && event.GetBlog().GetAuthorId() != req.GetAuthorId() {
+			return nil
+		}
+		return stream.Send(&blogpb.WatchBlogsResponse{
+			Event: event,
+		})
+	}
+
+	for _, event := range backlog {
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			fmt.Println("The client stopped watching blogs")
+			return nil
+		case event, ok := <-watcher.Events():
+			if !ok {
+				if watcher.Lagging() {
+					return status.Errorf(
+						codes.ResourceExhausted,
+						fmt.Sprintf("Client is too slow, resume from sequence %v", lastSequence),
+					)
+				}
+				return status.Errorf(
+					codes.Unavailable,
+					fmt.Sprintf("Server stopped watching, resume from sequence %v", lastSequence),
+				)
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *server) AddComment(ctx context.Context, req *blogpb.AddCommentRequest) (*blogpb.AddCommentResponse, error) {
+
+	fmt.Println("Add comment request")
+	comment := req.GetComment()
+	if comment.GetBlogId() == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing blog id in request",
+		)
+	}
+	if comment.GetContent() == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing comment content in request",
+		)
+	}
+
+	data, err := s.store.AddComment(&blogpb.Comment{
+		BlogId:   comment.GetBlogId(),
+		ParentId: comment.GetParentId(),
+		AuthorId: comment.GetAuthorId(),
+		Content:  comment.GetContent(),
+	})
+	if err != nil {
+		return nil, storeError(err, comment.GetBlogId())
+	}
+
+	return &blogpb.AddCommentResponse{
+		Comment: data,
+	}, nil
+}
+
+func (s *server) ListComments(req *blogpb.ListCommentsRequest, stream blogpb.BlogService_ListCommentsServer) error {
+
+	fmt.Println("List comments request")
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Missing blog id in request",
+		)
+	}
+
+	comments, err := s.store.ListComments(blogID)
+	if err != nil {
+		return storeError(err, blogID)
+	}
+
+	// comments are oldest first so replies keep their order in the thread
+	replies := make(map[string][]*blogpb.Comment)
+	found := req.GetParentId() == ""
+	for _, c := range comments {
+		replies[c.GetParentId()] = append(replies[c.GetParentId()], c)
+		if c.GetId() == req.GetParentId() {
+			found = true
+		}
+	}
+	if !found {
+		return storeError(blogstore.ErrCommentNotFound, blogID)
+	}
+
+	var sendThread func(parentID string, depth int32) error
+	sendThread = func(parentID string, depth int32) error {
+		for _, c := range replies[parentID] {
+			sendErr := stream.Send(&blogpb.ListCommentsResponse{
+				Comment: c,
+				Depth:   depth,
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+			if err := sendThread(c.GetId(), depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return sendThread(req.GetParentId(), 0)
+}
+
+func (s *server) DeleteComment(ctx context.Context, req *blogpb.DeleteCommentRequest) (*blogpb.DeleteCommentResponse, error) {
+
+	fmt.Println("Delete comment request")
+	blogID := req.GetBlogId()
+	commentID := req.GetCommentId()
+	if blogID == "" || commentID == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing blog id or comment id in request",
+		)
+	}
+
+	if err := s.store.DeleteComment(blogID, commentID); err != nil {
+		return nil, storeError(err, blogID)
+	}
+
+	return &blogpb.DeleteCommentResponse{
+		CommentId: commentID,
+	}, nil
+}
+
+func (s *server) CreateAuthor(ctx context.Context, req *blogpb.CreateAuthorRequest) (*blogpb.CreateAuthorResponse, error) {
+
+	fmt.Println("Create author request")
+	author := req.GetAuthor()
+	if author.GetName() == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing author name in request",
+		)
+	}
+
+	data, err := s.store.CreateAuthor(&blogpb.Author{
+		Id:   author.GetId(),
+		Name: author.GetName(),
+		Bio:  author.GetBio(),
+	})
+	if err != nil {
+		return nil, authorError(err, author.GetId())
+	}
+
+	return &blogpb.CreateAuthorResponse{
+		Author: data,
+	}, nil
+}
+
+func (s *server) GetAuthor(ctx context.Context, req *blogpb.GetAuthorRequest) (*blogpb.GetAuthorResponse, error) {
+
+	fmt.Println("Get author request")
+	authorID := req.GetAuthorId()
+	if authorID == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing author id in request",
+		)
+	}
+
+	data, err := s.store.GetAuthor(authorID)
+	if err != nil {
+		return nil, authorError(err, authorID)
+	}
+
+	return &blogpb.GetAuthorResponse{
+		Author: data,
+	}, nil
+}
+
+func (s *server) ListAuthors(ctx context.Context, req *blogpb.ListAuthorsRequest) (*blogpb.ListAuthorsResponse, error) {
+
+	fmt.Println("List authors request")
+	authors, err := s.store.ListAuthors()
+	if err != nil {
+		return nil, storeError(err, "")
+	}
+
+	return &blogpb.ListAuthorsResponse{
+		Authors: authors,
+	}, nil
+}
+
+func (s *server) ImportBlogs(stream blogpb.BlogService_ImportBlogsServer) error {
+
+	fmt.Println("Import blogs request")
+	res := &blogpb.ImportBlogsResponse{}
+	for index := int32(0); ; index++ {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			// We have finished reading the client stream
+			return stream.SendAndClose(res)
+		}
+		if err != nil {
+			return err
+		}
+
+		blog := req.GetBlog()
+		data := &blogpb.Blog{
+			Id:            blog.GetId(),
+			AuthorId:      blog.GetAuthorId(),
+			Title:         blog.GetTitle(),
+			Content:       blog.GetContent(),
+			ContentFormat: blog.GetContentFormat(),
+			// imported blogs are drafts like created ones
+			Status: blogpb.Blog_DRAFT,
+			Tags:   normalizeTags(blog.GetTags()),
+		}
+		err = blogvalidate.Error(blogvalidate.Blog(blog, "blog.", nil))
+		if err == nil {
+			data, err = s.store.Create(data)
+		}
+		if err != nil {
+			res.Failed++
+			res.Failures = append(res.Failures, &blogpb.ImportFailure{
+				Index:  index,
+				BlogId: blog.GetId(),
+				Error:  status.Convert(importError(err, blog.GetId())).Message(),
+			})
+			continue
+		}
+		res.Imported++
+	}
+}
+
+func (s *server) ExportBlogs(req *blogpb.ExportBlogsRequest, stream blogpb.BlogService_ExportBlogsServer) error {
+
+	fmt.Println("Export blogs request")
+	blogs, err := s.store.List()
+	if err != nil {
+		return storeError(err, "")
+	}
+
+	for _, blog := range blogs {
+		if req.GetAuthorId() != "" && blog.GetAuthorId() != req.GetAuthorId() {
+			continue
+		}
+		sendErr := stream.Send(&blogpb.ExportBlogsResponse{
+			Blog: blog,
+		})
+		if sendErr != nil {
+			return sendErr
+		}
+	}
+	return nil
+}
+
+func (s *server) RenderBlog(ctx context.Context, req *blogpb.RenderBlogRequest) (*blogpb.RenderBlogResponse, error) {
+
+	fmt.Println("Render blog request")
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing blog id in request",
+		)
+	}
+
+	blog, err := s.store.Get(blogID)
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+
+	rendered := s.renderCache.Render(blog)
+	return &blogpb.RenderBlogResponse{
+		BlogId:  blogID,
+		Version: blog.GetVersion(),
+		Html:    rendered.HTML,
+		Excerpt: rendered.Excerpt,
+	}, nil
+}
+
+// importError converts an error met while importing a blog into a gRPC status
+func importError(err error, blogID string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return storeError(err, blogID)
+}
+
+// authorError converts an error returned by the author methods of the
+// blog store into a gRPC status
+func authorError(err error, authorID string) error {
+	switch err {
+	case blogstore.ErrAuthorNotFound:
+		return status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find author with specified ID: %v", authorID),
+		)
+	case blogstore.ErrAuthorExists:
+		return status.Errorf(
+			codes.AlreadyExists,
+			fmt.Sprintf("Author already exists: %v", authorID),
+		)
+	}
+	return storeError(err, "")
+}
+
+// storeError converts an error returned by the blog store into a gRPC status
+func storeError(err error, blogID string) error {
+	if conflict, ok := err.(*blogstore.ConflictError); ok {
+		st := status.New(
+			codes.Aborted,
+			fmt.Sprintf("Blog %v was changed by someone else, current version is %v", blogID, conflict.CurrentVersion),
+		)
+		detailed, detailsErr := st.WithDetails(&blogpb.VersionConflict{
+			BlogId:         conflict.BlogID,
+			CurrentVersion: conflict.CurrentVersion,
+		})
+		if detailsErr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+	}
+
+	if quota, ok := err.(*blogstore.QuotaError); ok {
+		st := status.New(
+			codes.ResourceExhausted,
+			fmt.Sprintf("Author %v cannot store more than %v %v", quota.AuthorID, quota.Limit, quota.Resource),
+		)
+		detailed, detailsErr := st.WithDetails(&errdetails.QuotaFailure{
+			Violations: []*errdetails.QuotaFailure_Violation{{
+				Subject:     "author:" + quota.AuthorID,
+				Description: fmt.Sprintf("would store %v %v, the quota is %v", quota.Usage, quota.Resource, quota.Limit),
+			}},
+		})
+		if detailsErr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+	}
+
+	switch err {
+	case blogstore.ErrNotFound:
+		return status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find blog with specified ID: %v", blogID),
+		)
+	case blogstore.ErrExists:
+		return status.Errorf(
+			codes.AlreadyExists,
+			fmt.Sprintf("Blog already exists: %v", blogID),
+		)
+	case blogstore.ErrRevisionNotFound:
+		return status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find revision of blog: %v", blogID),
+		)
+	case blogstore.ErrCommentNotFound:
+		return status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find comment of blog: %v", blogID),
+		)
+	case blogstore.ErrAuthorNotFound:
+		return status.Errorf(
+			codes.FailedPrecondition,
+			"The author of the blog does not exist, create it first",
+		)
+	}
+	return status.Errorf(
+		codes.Internal,
+		fmt.Sprintf("Internal error: %v", err),
+	)
+}
+
+// encodeCursor turns the id of the last blog sent into an opaque cursor
+func encodeCursor(blogID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(blogID))
+}
+
+// decodeCursor returns the blog id a cursor points to
+func decodeCursor(cursor string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
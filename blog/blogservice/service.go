@@ -0,0 +1,265 @@
+// Package blogservice implements the blog service, so that it can be
+// served alone or next to other services.
+package blogservice
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"grpc-go-course/blog/blogattach"
+	"grpc-go-course/blog/blogidem"
+	"grpc-go-course/blog/bloglimit"
+	"grpc-go-course/blog/blogpb"
+	"grpc-go-course/blog/blogstore"
+	"grpc-go-course/blog/blogvalidate"
+	"grpc-go-course/runner"
+)
+
+const (
+	// serviceName is the name of the blog service in health checks
+	serviceName = "blog.BlogService"
+
+	// methodPrefix starts the full name of every method of the blog service
+	methodPrefix = "/" + serviceName + "/"
+)
+
+// Config holds the settings of the blog service
+type Config struct {
+	// Store is the blog storage: file or memory
+	Store string
+	// DataFile is the log file used by the file storage
+	DataFile string
+	// Attachments is the directory holding the blog attachments
+	Attachments string
+
+	// TrashRetention is how long deleted blogs stay in the trash, forever
+	// if 0
+	TrashRetention time.Duration
+	PurgeInterval  time.Duration
+
+	// IdempotencyTTL is how long responses are replayed to retried calls
+	IdempotencyTTL time.Duration
+
+	// WriteRate and WriteBurst limit the changes of each caller,
+	// no limit if WriteRate is 0
+	WriteRate  float64
+	WriteBurst int
+
+	// MaxBlogs and MaxBytes are the storage quota of each author, no limit
+	// if 0
+	MaxBlogs int64
+	MaxBytes int64
+
+	// HealthInterval is how often the storages are checked
+	HealthInterval time.Duration
+}
+
+// DefaultConfig returns the settings used unless configured otherwise
+func DefaultConfig() Config {
+	return Config{
+		Store:          "file",
+		DataFile:       "blog/data/blogs.log",
+		Attachments:    "blog/data/attachments",
+		TrashRetention: 30 * 24 * time.Hour,
+		PurgeInterval:  time.Hour,
+		IdempotencyTTL: 24 * time.Hour,
+		WriteRate:      5,
+		WriteBurst:     20,
+		MaxBlogs:       1000,
+		MaxBytes:       50 << 20,
+		HealthInterval: 5 * time.Second,
+	}
+}
+
+// Register declares the settings as flags of fs, with the values of c as
+// defaults
+func (c *Config) Register(fs *flag.FlagSet) {
+	fs.StringVar(&c.Store, "store", c.Store, "blog storage: file or memory")
+	fs.StringVar(&c.DataFile, "data", c.DataFile, "log file used by the file storage")
+	fs.StringVar(&c.Attachments, "attachments", c.Attachments, "directory holding the blog attachments")
+	fs.DurationVar(&c.TrashRetention, "trash-retention", c.TrashRetention, "how long deleted blogs stay in the trash, 0 keeps them forever")
+	fs.DurationVar(&c.PurgeInterval, "purge-interval", c.PurgeInterval, "how often the trash is purged")
+	fs.DurationVar(&c.IdempotencyTTL, "idempotency-ttl", c.IdempotencyTTL, "how long responses are replayed to calls retried with the same idempotency key")
+	fs.Float64Var(&c.WriteRate, "write-rate", c.WriteRate, "changes per second allowed to each caller, 0 for no limit")
+	fs.IntVar(&c.WriteBurst, "write-burst", c.WriteBurst, "changes allowed at once to each caller")
+	fs.Int64Var(&c.MaxBlogs, "max-blogs", c.MaxBlogs, "blogs each author can store, trash included, 0 for no limit")
+	fs.Int64Var(&c.MaxBytes, "max-bytes", c.MaxBytes, "bytes of titles and contents each author can store, trash included, 0 for no limit")
+	fs.DurationVar(&c.HealthInterval, "health-interval", c.HealthInterval, "how often the health of the blog storage is checked")
+}
+
+// check reports the settings that cannot work
+func (c *Config) check() error {
+	if c.PurgeInterval <= 0 {
+		return fmt.Errorf("the purge interval must be positive: %v", c.PurgeInterval)
+	}
+	if c.HealthInterval <= 0 {
+		return fmt.Errorf("the health interval must be positive: %v", c.HealthInterval)
+	}
+	if c.WriteRate > 0 && c.WriteBurst < 1 {
+		return fmt.Errorf("the write burst must be at least 1: %v", c.WriteBurst)
+	}
+	return nil
+}
+
+// Service is the blog service with its storages and background jobs
+type Service struct {
+	server *server
+	config Config
+
+	unary  grpc.UnaryServerInterceptor
+	stream grpc.StreamServerInterceptor
+
+	stop chan struct{}
+	jobs sync.WaitGroup
+}
+
+// Open opens the storages configured by c and loads the blogs
+func Open(c Config) (*Service, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	var store blogstore.BlogStore
+	switch c.Store {
+	case "memory":
+		store = blogstore.NewMemoryStore()
+	case "file":
+		fileStore, err := blogstore.OpenFileStore(c.DataFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot open blog storage: %v", err)
+		}
+		store = fileStore
+	default:
+		return nil, fmt.Errorf("unknown blog storage: %v", c.Store)
+	}
+	store.SetQuota(blogstore.Quota{
+		MaxBlogs: c.MaxBlogs,
+		MaxBytes: c.MaxBytes,
+	})
+
+	attachments, err := blogattach.Open(c.Attachments, maxAttachmentSize)
+	if err != nil {
+		store.Close()
+		return nil, fmt.Errorf("cannot open attachment storage: %v", err)
+	}
+
+	s, err := newServer(store, attachments)
+	if err != nil {
+		store.Close()
+		return nil, fmt.Errorf("cannot load blogs: %v", err)
+	}
+
+	var unary []grpc.UnaryServerInterceptor
+	var stream []grpc.StreamServerInterceptor
+	if c.WriteRate > 0 {
+		limiter := bloglimit.NewLimiter(c.WriteRate, c.WriteBurst, limitedMethods...)
+		unary = append(unary, limiter.UnaryServerInterceptor)
+		stream = append(stream, limiter.StreamServerInterceptor)
+	}
+	idempotency := blogidem.NewCache(c.IdempotencyTTL, maxIdempotencyKeys, idempotentMethods...)
+	unary = append(unary,
+		idempotency.UnaryServerInterceptor,
+		blogvalidate.UnaryServerInterceptor,
+	)
+
+	return &Service{
+		server: s,
+		config: c,
+		unary:  runner.ChainUnary(unary...),
+		stream: runner.ChainStream(stream...),
+		stop:   make(chan struct{}),
+	}, nil
+}
+
+// Register registers the blog service on s
+func (svc *Service) Register(s *grpc.Server) {
+	blogpb.RegisterBlogServiceServer(s, svc.server)
+}
+
+// UnaryServerInterceptor rate limits, deduplicates and validates the calls
+// to the blog service, and lets the calls to other services through
+func (svc *Service) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !strings.HasPrefix(info.FullMethod, methodPrefix) {
+		return handler(ctx, req)
+	}
+	return svc.unary(ctx, req, info, handler)
+}
+
+// StreamServerInterceptor rate limits the streams of the blog service, and
+// lets the streams of other services through
+func (svc *Service) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !strings.HasPrefix(info.FullMethod, methodPrefix) {
+		return handler(srv, ss)
+	}
+	return svc.stream(srv, ss, info, handler)
+}
+
+// Start starts publishing the scheduled blogs and purging the trash
+func (svc *Service) Start() {
+	svc.jobs.Add(1)
+	go func() {
+		defer svc.jobs.Done()
+		svc.server.runScheduler(svc.stop)
+	}()
+
+	if svc.config.TrashRetention > 0 {
+		svc.jobs.Add(1)
+		go func() {
+			defer svc.jobs.Done()
+			svc.server.runPurger(svc.config.TrashRetention, svc.config.PurgeInterval, svc.stop)
+		}()
+	}
+}
+
+// ReportHealth checks the blog storage until the service is closed and
+// reports the blog service as not serving on hs while it is unavailable
+func (svc *Service) ReportHealth(hs *health.Server) {
+	svc.jobs.Add(1)
+	go func() {
+		defer svc.jobs.Done()
+		ticker := time.NewTicker(svc.config.HealthInterval)
+		defer ticker.Stop()
+		var failure error
+		for {
+			err := svc.server.store.Check()
+			switch {
+			case err != nil && failure == nil:
+				log.Printf("The blog storage is unavailable: %v", err)
+				hs.SetServingStatus(serviceName, healthpb.HealthCheckResponse_NOT_SERVING)
+			case err == nil && failure != nil:
+				log.Printf("The blog storage is available again")
+				hs.SetServingStatus(serviceName, healthpb.HealthCheckResponse_SERVING)
+			}
+			failure = err
+
+			select {
+			case <-ticker.C:
+			case <-svc.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Drain ends the watch streams, which never stop on their own; watchers
+// are told to resume elsewhere
+func (svc *Service) Drain() {
+	svc.server.feed.Close()
+}
+
+// Close stops the background jobs and closes the blog storage
+func (svc *Service) Close() error {
+	fmt.Println("Stopping the scheduler and the trash purge")
+	close(svc.stop)
+	svc.jobs.Wait()
+	fmt.Println("Closing the blog storage")
+	return svc.server.store.Close()
+}
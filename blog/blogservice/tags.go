@@ -0,0 +1,75 @@
+package blogservice
+
+import (
+	"fmt"
+	"golang.org/x/net/context"
+	"grpc-go-course/blog/blogpb"
+	"grpc-go-course/blog/blogstore"
+	"strings"
+)
+
+// normalizeTags lower cases tags and drops empty and repeated ones,
+// keeping the order they were given in
+func normalizeTags(tags []string) []string {
+	var result []string
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+// matchTags reports whether blog has any of the wanted tags, or all of
+// them if all is set. Every blog matches when no tag is wanted.
+func matchTags(blog *blogpb.Blog, wanted []string, all bool) bool {
+	if len(wanted) == 0 {
+		return true
+	}
+	has := make(map[string]bool)
+	for _, tag := range blog.GetTags() {
+		has[tag] = true
+	}
+	for _, tag := range wanted {
+		if has[tag] && !all {
+			return true
+		}
+		if !has[tag] && all {
+			return false
+		}
+	}
+	return all
+}
+
+// tagFacets counts how many of blogs use each tag, most used first
+func tagFacets(blogs []*blogpb.Blog) []*blogpb.TagCount {
+	counts := make(map[string]int64)
+	for _, blog := range blogs {
+		for _, tag := range blog.GetTags() {
+			counts[tag]++
+		}
+	}
+	return blogstore.SortedTagCounts(counts)
+}
+
+func (s *server) ListTags(ctx context.Context, req *blogpb.ListTagsRequest) (*blogpb.ListTagsResponse, error) {
+
+	fmt.Println("List tags request")
+	statuses := req.GetStatuses()
+	if len(statuses) == 0 {
+		statuses = []blogpb.Blog_Status{blogpb.Blog_PUBLISHED}
+	}
+
+	tags, err := s.store.TagCounts(statuses...)
+	if err != nil {
+		return nil, storeError(err, "")
+	}
+
+	return &blogpb.ListTagsResponse{
+		Tags: tags,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package blogservice
+
+import (
+	"fmt"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc-go-course/blog/blogpb"
+	"grpc-go-course/blog/blogstore"
+	"log"
+	"time"
+)
+
+func (s *server) RestoreBlog(ctx context.Context, req *blogpb.RestoreBlogRequest) (*blogpb.RestoreBlogResponse, error) {
+
+	fmt.Println("Restore blog request")
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missing blog id in request",
+		)
+	}
+
+	data, err := s.store.Restore(blogID)
+	if err != nil {
+		return nil, trashError(err, blogID)
+	}
+
+	return &blogpb.RestoreBlogResponse{
+		Blog: data,
+	}, nil
+}
+
+func (s *server) ListTrash(ctx context.Context, req *blogpb.ListTrashRequest) (*blogpb.ListTrashResponse, error) {
+
+	fmt.Println("List trash request")
+	blogs, err := s.store.ListTrash()
+	if err != nil {
+		return nil, storeError(err, "")
+	}
+
+	res := &blogpb.ListTrashResponse{}
+	for _, blog := range blogs {
+		if req.GetAuthorId() != "" && blog.GetAuthorId() != req.GetAuthorId() {
+			continue
+		}
+		res.Blogs = append(res.Blogs, blog)
+	}
+	return res, nil
+}
+
+// runPurger removes for good the blogs that stayed in the trash longer
+// than retention, looking at the trash every interval until stop is closed
+func (s *server) runPurger(retention time.Duration, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.purgeTrash(time.Now().Add(-retention))
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// purgeTrash removes for good the blogs deleted before deletedBefore
+func (s *server) purgeTrash(deletedBefore time.Time) {
+	purged, err := s.store.Purge(deletedBefore)
+	if err != nil {
+		log.Printf("Cannot purge the trash: %v", err)
+		return
+	}
+	for _, blog := range purged {
+		fmt.Printf("Purged deleted blog %v\n", blog.GetId())
+		if err := s.attachments.DeleteBlog(blog.GetId()); err != nil {
+			log.Printf("Cannot delete the attachments of purged blog %v: %v", blog.GetId(), err)
+		}
+	}
+}
+
+// trashError converts an error returned while taking a blog out of the
+// trash into a gRPC status
+func trashError(err error, blogID string) error {
+	if err == blogstore.ErrNotFound {
+		return status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Cannot find blog with specified ID in the trash: %v", blogID),
+		)
+	}
+	return storeError(err, blogID)
+}
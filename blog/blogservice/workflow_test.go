@@ -0,0 +1,171 @@
+package blogservice
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc-go-course/blog/blogpb"
+	"grpc-go-course/blog/blogstore"
+	"testing"
+	"time"
+)
+
+// newTestServer returns a server keeping its blogs in memory, with an
+// author named ann
+func newTestServer(t *testing.T) *server {
+	store := blogstore.NewMemoryStore()
+	if _, err := store.CreateAuthor(&blogpb.Author{Id: "ann", Name: "Ann"}); err != nil {
+		t.Fatal(err)
+	}
+	s, err := newServer(store, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestCreateBlogStartsAsDraft(t *testing.T) {
+	publishAt, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name   string
+		status blogpb.Blog_Status
+	}{
+		{name: "draft", status: blogpb.Blog_DRAFT},
+		{name: "scheduled", status: blogpb.Blog_SCHEDULED},
+		{name: "published", status: blogpb.Blog_PUBLISHED},
+		{name: "archived", status: blogpb.Blog_ARCHIVED},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			res, err := s.CreateBlog(context.Background(), &blogpb.CreateBlogRequest{
+				Blog: &blogpb.Blog{
+					AuthorId:  "ann",
+					Title:     "title",
+					Content:   "content",
+					Status:    tt.status,
+					PublishAt: publishAt,
+				},
+			})
+			if err != nil {
+				t.Fatalf("CreateBlog: %v", err)
+			}
+			if got := res.GetBlog().GetStatus(); got != blogpb.Blog_DRAFT {
+				t.Errorf("status = %v, want DRAFT", got)
+			}
+			if res.GetBlog().GetPublishAt() != nil {
+				t.Errorf("publish_at = %v, want none", res.GetBlog().GetPublishAt())
+			}
+		})
+	}
+}
+
+func TestUpdateBlogKeepsWorkflow(t *testing.T) {
+	later, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name string
+		// publishAt schedules the blog an hour later instead of publishing
+		// it right away before the update
+		publishAt  bool
+		wantStatus blogpb.Blog_Status
+		// changes is sent to UpdateBlog with mask
+		changes  *blogpb.Blog
+		mask     []string
+		wantCode codes.Code
+	}{
+		{
+			name:       "published blog without mask",
+			wantStatus: blogpb.Blog_PUBLISHED,
+			changes:    &blogpb.Blog{AuthorId: "ann", Title: "edited", Content: "edited"},
+		},
+		{
+			name:       "scheduled blog without mask",
+			publishAt:  true,
+			wantStatus: blogpb.Blog_SCHEDULED,
+			changes:    &blogpb.Blog{AuthorId: "ann", Title: "edited", Content: "edited"},
+		},
+		{
+			name:       "published blog set to draft without mask",
+			wantStatus: blogpb.Blog_PUBLISHED,
+			changes:    &blogpb.Blog{AuthorId: "ann", Title: "edited", Status: blogpb.Blog_DRAFT},
+		},
+		{
+			name:       "scheduled blog set to archived without mask",
+			publishAt:  true,
+			wantStatus: blogpb.Blog_SCHEDULED,
+			changes:    &blogpb.Blog{AuthorId: "ann", Title: "edited", Status: blogpb.Blog_ARCHIVED},
+		},
+		{
+			name:       "published blog with a mask",
+			wantStatus: blogpb.Blog_PUBLISHED,
+			changes:    &blogpb.Blog{Title: "edited"},
+			mask:       []string{"title"},
+		},
+		{
+			name:       "status in the mask",
+			wantStatus: blogpb.Blog_PUBLISHED,
+			changes:    &blogpb.Blog{Status: blogpb.Blog_DRAFT},
+			mask:       []string{"status"},
+			wantCode:   codes.InvalidArgument,
+		},
+		{
+			name:       "publish time in the mask",
+			publishAt:  true,
+			wantStatus: blogpb.Blog_SCHEDULED,
+			changes:    &blogpb.Blog{},
+			mask:       []string{"publish_at"},
+			wantCode:   codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			ctx := context.Background()
+			created, err := s.CreateBlog(ctx, &blogpb.CreateBlogRequest{
+				Blog: &blogpb.Blog{AuthorId: "ann", Title: "title", Content: "content"},
+			})
+			if err != nil {
+				t.Fatalf("CreateBlog: %v", err)
+			}
+			publishReq := &blogpb.PublishBlogRequest{BlogId: created.GetBlog().GetId()}
+			if tt.publishAt {
+				publishReq.PublishAt = later
+			}
+			published, err := s.PublishBlog(ctx, publishReq)
+			if err != nil {
+				t.Fatalf("PublishBlog: %v", err)
+			}
+
+			changes := tt.changes
+			changes.Id = created.GetBlog().GetId()
+			_, err = s.UpdateBlog(ctx, &blogpb.UpdateBlogRequest{
+				Blog:       changes,
+				UpdateMask: &field_mask.FieldMask{Paths: tt.mask},
+			})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("UpdateBlog error = %v, want %v", err, tt.wantCode)
+			}
+
+			blog, err := s.store.Get(changes.GetId())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if blog.GetStatus() != tt.wantStatus {
+				t.Errorf("status = %v, want %v", blog.GetStatus(), tt.wantStatus)
+			}
+			want := published.GetBlog().GetPublishAt()
+			if blog.GetPublishAt().GetSeconds() != want.GetSeconds() || blog.GetPublishAt().GetNanos() != want.GetNanos() {
+				t.Errorf("publish_at = %v, want %v", blog.GetPublishAt(), want)
+			}
+			if tt.wantCode == codes.OK && blog.GetTitle() != "edited" {
+				t.Errorf("title = %q, want the update applied", blog.GetTitle())
+			}
+		})
+	}
+}
@@ -0,0 +1,327 @@
+package blogstore
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"grpc-go-course/blog/blogpb"
+)
+
+// fileRecord is the JSON form of a record in the log.
+// Each line of the log holds the batch of records of one change.
+type fileRecord struct {
+	Op       string          `json:"op"`
+	ID       string          `json:"id"`
+	Blog     json.RawMessage `json:"blog,omitempty"`
+	Revision json.RawMessage `json:"revision,omitempty"`
+	Comment  json.RawMessage `json:"comment,omitempty"`
+	Author   json.RawMessage `json:"author,omitempty"`
+}
+
+// FileStore keeps blogs in memory and appends every change to a log file,
+// so the blogs survive restarts without any external database.
+// The log is replayed and compacted when the store is opened.
+type FileStore struct {
+	*MemoryStore
+	path string
+	file *os.File
+	// size is the length of the log up to the end of the last good batch
+	size int64
+
+	// writeErr is the error of the first failed write to the log. Nothing
+	// is written to the log after it until the store is reopened.
+	writeErr error
+}
+
+// OpenFileStore opens (or creates) the log at path and replays it
+func OpenFileStore(path string) (*FileStore, error) {
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, err
+		}
+	}
+
+	s := &FileStore{
+		MemoryStore: NewMemoryStore(),
+		path:        path,
+	}
+	if err := s.replay(); err != nil {
+		return nil, err
+	}
+	if err := s.compact(); err != nil {
+		return nil, err
+	}
+	s.journal = s.write
+	return s, nil
+}
+
+// replay loads the log into memory. A torn batch at the end of the file,
+// left by a crash in the middle of a write, is dropped.
+func (s *FileStore) replay() error {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			// anything left without a newline is a partial write
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		recs, err := decodeBatch(line)
+		if err != nil {
+			if _, peekErr := r.Peek(1); peekErr == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("corrupt record in %v: %v", s.path, err)
+		}
+		for _, rec := range recs {
+			if err := s.apply(rec); err != nil {
+				return fmt.Errorf("corrupt record in %v: %v", s.path, err)
+			}
+		}
+	}
+}
+
+// compact rewrites the log so it only holds the authors and the current
+// and trashed blogs with their revisions and comments, then reopens it for
+// appending
+func (s *FileStore) compact() error {
+	tmp := s.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	for _, author := range sortedAuthors(s.authors) {
+		line, err := encodeBatch([]record{authorRecord(author)})
+		if err != nil {
+			f.Close()
+			return err
+		}
+		if _, err := w.Write(line); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	blogs := append(sortedBlogs(s.blogs), sortedBlogs(s.trash)...)
+	for _, blog := range blogs {
+		var recs []record
+		for _, rev := range s.revisions[blog.GetId()] {
+			recs = append(recs, revisionRecord(rev))
+		}
+		if blog.GetDeletedAt() != nil {
+			recs = append(recs, trashRecord(blog))
+		} else {
+			recs = append(recs, putRecord(blog))
+		}
+		for _, comment := range s.comments[blog.GetId()] {
+			recs = append(recs, commentRecord(comment))
+		}
+		line, err := encodeBatch(recs)
+		if err != nil {
+			f.Close()
+			return err
+		}
+		if _, err := w.Write(line); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return err
+	}
+
+	if s.file != nil {
+		s.file.Close()
+	}
+	s.file, err = os.OpenFile(s.path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := s.file.Stat()
+	if err != nil {
+		return err
+	}
+	s.size = info.Size()
+	return nil
+}
+
+func encodeBatch(recs []record) ([]byte, error) {
+	m := jsonpb.Marshaler{OrigName: true}
+	batch := make([]fileRecord, len(recs))
+	for i, rec := range recs {
+		batch[i] = fileRecord{Op: rec.op, ID: rec.id}
+		if rec.blog != nil {
+			data, err := m.MarshalToString(rec.blog)
+			if err != nil {
+				return nil, err
+			}
+			batch[i].Blog = json.RawMessage(data)
+		}
+		if rec.revision != nil {
+			data, err := m.MarshalToString(rec.revision)
+			if err != nil {
+				return nil, err
+			}
+			batch[i].Revision = json.RawMessage(data)
+		}
+		if rec.comment != nil {
+			data, err := m.MarshalToString(rec.comment)
+			if err != nil {
+				return nil, err
+			}
+			batch[i].Comment = json.RawMessage(data)
+		}
+		if rec.author != nil {
+			data, err := m.MarshalToString(rec.author)
+			if err != nil {
+				return nil, err
+			}
+			batch[i].Author = json.RawMessage(data)
+		}
+	}
+	line, err := json.Marshal(batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
+
+func decodeBatch(line []byte) ([]record, error) {
+	var batch []fileRecord
+	if err := json.Unmarshal(line, &batch); err != nil {
+		return nil, err
+	}
+	recs := make([]record, len(batch))
+	for i, fr := range batch {
+		recs[i] = record{op: fr.Op, id: fr.ID}
+		if len(fr.Blog) > 0 {
+			recs[i].blog = &blogpb.Blog{}
+			if err := unmarshalJSON(fr.Blog, recs[i].blog); err != nil {
+				return nil, err
+			}
+		}
+		if len(fr.Revision) > 0 {
+			recs[i].revision = &blogpb.BlogRevision{}
+			if err := unmarshalJSON(fr.Revision, recs[i].revision); err != nil {
+				return nil, err
+			}
+		}
+		if len(fr.Comment) > 0 {
+			recs[i].comment = &blogpb.Comment{}
+			if err := unmarshalJSON(fr.Comment, recs[i].comment); err != nil {
+				return nil, err
+			}
+		}
+		if len(fr.Author) > 0 {
+			recs[i].author = &blogpb.Author{}
+			if err := unmarshalJSON(fr.Author, recs[i].author); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return recs, nil
+}
+
+func unmarshalJSON(data []byte, pb proto.Message) error {
+	u := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	return u.Unmarshal(bytes.NewReader(data), pb)
+}
+
+// write appends a batch of records to the log and syncs it to disk.
+// It is called by the memory store with the write lock held.
+func (s *FileStore) write(recs []record) error {
+	if s.file == nil {
+		return os.ErrClosed
+	}
+	if s.writeErr != nil {
+		return fmt.Errorf("the log cannot be written until the store is reopened: %v", s.writeErr)
+	}
+	line, err := encodeBatch(recs)
+	if err != nil {
+		return err
+	}
+	if _, err := s.file.Write(line); err != nil {
+		return s.fail(err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return s.fail(err)
+	}
+	s.size += int64(len(line))
+	return nil
+}
+
+// fail cuts what a failed write left after the last good batch, so that
+// the next batch doesn't extend a partial line, and refuses any other
+// write since the state of the disk is unknown
+func (s *FileStore) fail(err error) error {
+	s.writeErr = err
+	if truncErr := s.file.Truncate(s.size); truncErr != nil {
+		return fmt.Errorf("%v, and cannot cut the partial batch: %v", err, truncErr)
+	}
+	return err
+}
+
+// Check fails when the store is closed, when the last write to the log
+// failed, or when the log was removed or replaced behind its back
+func (s *FileStore) Check() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.file == nil {
+		return os.ErrClosed
+	}
+	if s.writeErr != nil {
+		return fmt.Errorf("cannot write the log: %v", s.writeErr)
+	}
+	open, err := s.file.Stat()
+	if err != nil {
+		return err
+	}
+	onDisk, err := os.Stat(s.path)
+	if err != nil {
+		return err
+	}
+	if !os.SameFile(open, onDisk) {
+		return fmt.Errorf("the log %v was replaced", s.path)
+	}
+	return nil
+}
+
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
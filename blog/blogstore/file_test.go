@@ -0,0 +1,257 @@
+package blogstore
+
+import (
+	"bufio"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"grpc-go-course/blog/blogpb"
+)
+
+// openTestStore opens a file store in a new temporary directory holding an
+// author and two blogs, and returns it with the path of its log
+func openTestStore(t *testing.T) (*FileStore, string) {
+	dir, err := ioutil.TempDir("", "blogstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "blogs.log")
+
+	s, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore: %v", err)
+	}
+	if _, err := s.CreateAuthor(&blogpb.Author{Id: "ann", Name: "Ann"}); err != nil {
+		t.Fatalf("CreateAuthor: %v", err)
+	}
+	for _, id := range []string{"first", "second"} {
+		blog := &blogpb.Blog{Id: id, AuthorId: "ann", Title: id, Content: "content of " + id}
+		if _, err := s.Create(blog); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+	}
+	return s, path
+}
+
+// appendLog appends text to the log at path
+func appendLog(t *testing.T, path string, text string) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(text); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// countLines returns the number of lines of the log at path
+func countLines(t *testing.T, path string) int {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	n := 0
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		n++
+	}
+	return n
+}
+
+func TestFileStoreReplay(t *testing.T) {
+	third, err := encodeBatch([]record{putRecord(&blogpb.Blog{
+		Id: "third", AuthorId: "ann", Title: "third", Version: 1,
+	})})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		// tail is appended to the log of a store holding two blogs
+		tail      string
+		wantBlogs []string
+		wantErr   string
+	}{
+		{
+			name:      "clean log",
+			wantBlogs: []string{"first", "second"},
+		},
+		{
+			name:      "good batch",
+			tail:      string(third),
+			wantBlogs: []string{"first", "second", "third"},
+		},
+		{
+			name:      "torn last batch",
+			tail:      string(third[:len(third)/2]),
+			wantBlogs: []string{"first", "second"},
+		},
+		{
+			name:      "corrupt last line",
+			tail:      "not json\n",
+			wantBlogs: []string{"first", "second"},
+		},
+		{
+			name:    "corrupt line before a good batch",
+			tail:    "not json\n" + string(third),
+			wantErr: "corrupt record",
+		},
+		{
+			// what a later write appended onto a partial batch looks like
+			name:    "torn batch extended by later batches",
+			tail:    string(third[:len(third)/2]) + string(third) + string(third),
+			wantErr: "corrupt record",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, path := openTestStore(t)
+			s.Close()
+			appendLog(t, path, tt.tail)
+
+			s, err := OpenFileStore(path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("OpenFileStore error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("OpenFileStore: %v", err)
+			}
+			defer s.Close()
+
+			blogs, err := s.List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []string
+			for _, blog := range blogs {
+				ids = append(ids, blog.GetId())
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.wantBlogs, ",") {
+				t.Errorf("blogs = %v, want %v", ids, tt.wantBlogs)
+			}
+			// the log was compacted, so the next open finds no torn batch
+			if got, want := countLines(t, path), 1+len(tt.wantBlogs); got != want {
+				t.Errorf("log has %v lines after compaction, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestFileStoreCompactionKeepsEverything(t *testing.T) {
+	s, path := openTestStore(t)
+	for i := 0; i < 3; i++ {
+		blog, err := s.Get("first")
+		if err != nil {
+			t.Fatal(err)
+		}
+		blog.Content += " edited"
+		if _, err := s.Update(blog, "ann"); err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+	}
+	if _, err := s.AddComment(&blogpb.Comment{BlogId: "first", AuthorId: "ann", Content: "hi"}); err != nil {
+		t.Fatalf("AddComment: %v", err)
+	}
+	if _, err := s.Delete("second", 0); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	s.Close()
+
+	// reopening twice makes sure a compacted log replays to the same state
+	for i := 0; i < 2; i++ {
+		s, err := OpenFileStore(path)
+		if err != nil {
+			t.Fatalf("OpenFileStore: %v", err)
+		}
+
+		blog, err := s.Get("first")
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if blog.GetVersion() != 4 || !strings.HasSuffix(blog.GetContent(), " edited edited edited") {
+			t.Errorf("blog = version %v %q, want version 4 edited 3 times", blog.GetVersion(), blog.GetContent())
+		}
+		revisions, err := s.ListRevisions("first")
+		if err != nil || len(revisions) != 4 {
+			t.Errorf("ListRevisions = %v revisions, %v, want 4", len(revisions), err)
+		}
+		comments, err := s.ListComments("first")
+		if err != nil || len(comments) != 1 {
+			t.Errorf("ListComments = %v comments, %v, want 1", len(comments), err)
+		}
+		if _, err := s.Get("second"); err != ErrNotFound {
+			t.Errorf("Get of a deleted blog = %v, want ErrNotFound", err)
+		}
+		trash, err := s.ListTrash()
+		if err != nil || len(trash) != 1 || trash[0].GetId() != "second" {
+			t.Errorf("ListTrash = %v, %v, want the second blog", trash, err)
+		}
+		s.Close()
+	}
+}
+
+func TestFileStoreFailedWrite(t *testing.T) {
+	s, path := openTestStore(t)
+	before, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a write cut by a full disk leaves part of a batch in the log
+	if _, err := s.file.WriteString(`[{"op":"put","id":"lost"`); err != nil {
+		t.Fatal(err)
+	}
+	s.mu.Lock()
+	err = s.fail(errors.New("no space left on device"))
+	s.mu.Unlock()
+	if err == nil {
+		t.Fatal("fail returned no error")
+	}
+	if after, err := os.Stat(path); err != nil || after.Size() != before.Size() {
+		t.Errorf("log is %v bytes after the failed write, want %v", after.Size(), before.Size())
+	}
+
+	if _, err := s.Create(&blogpb.Blog{AuthorId: "ann", Title: "after"}); err == nil {
+		t.Error("Create succeeded after a failed write")
+	}
+	if err := s.Check(); err == nil {
+		t.Error("Check succeeded after a failed write")
+	}
+	s.Close()
+
+	s, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore after a failed write: %v", err)
+	}
+	defer s.Close()
+	if err := s.Check(); err != nil {
+		t.Errorf("Check after reopening: %v", err)
+	}
+	if _, err := s.Create(&blogpb.Blog{Id: "after", AuthorId: "ann", Title: "after"}); err != nil {
+		t.Fatalf("Create after reopening: %v", err)
+	}
+	s.Close()
+
+	s, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore: %v", err)
+	}
+	defer s.Close()
+	blogs, err := s.List()
+	if err != nil || len(blogs) != 3 {
+		t.Errorf("List = %v blogs, %v, want 3", len(blogs), err)
+	}
+}
@@ -0,0 +1,552 @@
+package blogstore
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"grpc-go-course/blog/blogpb"
+)
+
+// MemoryStore keeps blogs in a map. Everything is lost when the process exits.
+type MemoryStore struct {
+	mu        sync.RWMutex
+	blogs     map[string]*blogpb.Blog
+	trash     map[string]*blogpb.Blog
+	revisions map[string][]*blogpb.BlogRevision
+	// comments of each blog, oldest first
+	comments map[string][]*blogpb.Comment
+	authors  map[string]*blogpb.Author
+	// number of blogs using each tag, by status of the blogs
+	tags map[blogpb.Blog_Status]map[string]int64
+	// what each author stores, trash included
+	usage map[string]Usage
+	quota Quota
+
+	// journal, when set, is given every batch of records before it is
+	// applied. The batch is dropped if journal fails.
+	journal func(recs []record) error
+
+	// listeners are given the blogs changed by the committed batches
+	listeners []func(eventType blogpb.BlogEvent_Type, blog *blogpb.Blog)
+}
+
+// NewMemoryStore returns an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		blogs:     make(map[string]*blogpb.Blog),
+		trash:     make(map[string]*blogpb.Blog),
+		revisions: make(map[string][]*blogpb.BlogRevision),
+		comments:  make(map[string][]*blogpb.Comment),
+		authors:   make(map[string]*blogpb.Author),
+		tags:      make(map[blogpb.Blog_Status]map[string]int64),
+		usage:     make(map[string]Usage),
+	}
+}
+
+// commit journals and applies a batch of records.
+// Callers must hold the write lock.
+func (s *MemoryStore) commit(recs ...record) error {
+	if s.journal != nil {
+		if err := s.journal(recs); err != nil {
+			return err
+		}
+	}
+	for _, rec := range recs {
+		if err := s.apply(rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notify gives a committed change to the listeners.
+// Callers must hold the write lock.
+func (s *MemoryStore) notify(eventType blogpb.BlogEvent_Type, blog *blogpb.Blog) {
+	for _, f := range s.listeners {
+		f(eventType, cloneBlog(blog))
+	}
+}
+
+// apply changes the content of the store according to rec
+func (s *MemoryStore) apply(rec record) error {
+	switch rec.op {
+	case opPut:
+		if old, ok := s.blogs[rec.id]; ok {
+			s.countTags(old, -1)
+			s.countUsage(old, -1)
+		}
+		if old, ok := s.trash[rec.id]; ok {
+			s.countUsage(old, -1)
+		}
+		delete(s.trash, rec.id)
+		s.blogs[rec.id] = rec.blog
+		s.countTags(rec.blog, 1)
+		s.countUsage(rec.blog, 1)
+	case opTrash:
+		if old, ok := s.blogs[rec.id]; ok {
+			s.countTags(old, -1)
+			s.countUsage(old, -1)
+		}
+		delete(s.blogs, rec.id)
+		s.trash[rec.id] = rec.blog
+		s.countUsage(rec.blog, 1)
+	case opDelete:
+		if old, ok := s.blogs[rec.id]; ok {
+			s.countTags(old, -1)
+			s.countUsage(old, -1)
+		}
+		if old, ok := s.trash[rec.id]; ok {
+			s.countUsage(old, -1)
+		}
+		delete(s.blogs, rec.id)
+		delete(s.trash, rec.id)
+		delete(s.revisions, rec.id)
+		delete(s.comments, rec.id)
+	case opRevision:
+		s.revisions[rec.id] = append(s.revisions[rec.id], rec.revision)
+	case opComment:
+		s.comments[rec.id] = append(s.comments[rec.id], rec.comment)
+	case opDeleteComment:
+		comments := s.comments[rec.id]
+		for i, c := range comments {
+			if c.GetId() == rec.comment.GetId() {
+				s.comments[rec.id] = append(comments[:i:i], comments[i+1:]...)
+				break
+			}
+		}
+	case opAuthor:
+		s.authors[rec.id] = rec.author
+	default:
+		return fmt.Errorf("unknown operation %q", rec.op)
+	}
+	return nil
+}
+
+// countTags adds delta to the count of every tag of blog
+func (s *MemoryStore) countTags(blog *blogpb.Blog, delta int64) {
+	counts, ok := s.tags[blog.GetStatus()]
+	if !ok {
+		counts = make(map[string]int64)
+		s.tags[blog.GetStatus()] = counts
+	}
+	for _, tag := range blog.GetTags() {
+		counts[tag] += delta
+		if counts[tag] <= 0 {
+			delete(counts, tag)
+		}
+	}
+}
+
+// countUsage adds delta times blog to the usage of its author
+func (s *MemoryStore) countUsage(blog *blogpb.Blog, delta int64) {
+	u := s.usage[blog.GetAuthorId()]
+	u.Blogs += delta
+	u.Bytes += delta * blogSize(blog)
+	if u.Blogs <= 0 {
+		delete(s.usage, blog.GetAuthorId())
+		return
+	}
+	s.usage[blog.GetAuthorId()] = u
+}
+
+// checkQuota returns a *QuotaError if replacing old, nil for a new blog,
+// by blog takes the author of blog over quota and makes it store more.
+// Callers must hold the lock.
+func (s *MemoryStore) checkQuota(old *blogpb.Blog, blog *blogpb.Blog) error {
+	authorID := blog.GetAuthorId()
+	u := s.usage[authorID]
+	added := Usage{Blogs: 1, Bytes: blogSize(blog)}
+	if old != nil && old.GetAuthorId() == authorID {
+		added = Usage{Bytes: blogSize(blog) - blogSize(old)}
+	}
+	if max := s.quota.MaxBlogs; max > 0 && added.Blogs > 0 && u.Blogs+added.Blogs > max {
+		return &QuotaError{AuthorID: authorID, Resource: "blogs", Limit: max, Usage: u.Blogs + added.Blogs}
+	}
+	if max := s.quota.MaxBytes; max > 0 && added.Bytes > 0 && u.Bytes+added.Bytes > max {
+		return &QuotaError{AuthorID: authorID, Resource: "bytes", Limit: max, Usage: u.Bytes + added.Bytes}
+	}
+	return nil
+}
+
+// checkVersion returns a *ConflictError unless version is 0 or the
+// current version of the blog. Callers must hold the lock.
+func (s *MemoryStore) checkVersion(blogID string, version int64) error {
+	current := s.blogs[blogID].GetVersion()
+	if version != 0 && version != current {
+		return &ConflictError{BlogID: blogID, CurrentVersion: current}
+	}
+	return nil
+}
+
+// nextRevision returns the number of the next revision of a blog.
+// It is also the next version of the blog.
+// Callers must hold the lock.
+func (s *MemoryStore) nextRevision(blogID string) int64 {
+	revs := s.revisions[blogID]
+	if len(revs) == 0 {
+		return 1
+	}
+	return revs[len(revs)-1].GetRevision() + 1
+}
+
+func (s *MemoryStore) Create(blog *blogpb.Blog) (*blogpb.Blog, error) {
+	data := cloneBlog(blog)
+	if data.GetId() == "" {
+		id, err := newID()
+		if err != nil {
+			return nil, err
+		}
+		data.Id = id
+	}
+	data.Version = 1
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.blogs[data.GetId()]; ok {
+		return nil, ErrExists
+	}
+	if _, ok := s.trash[data.GetId()]; ok {
+		return nil, ErrExists
+	}
+	if _, ok := s.authors[data.GetAuthorId()]; !ok {
+		return nil, ErrAuthorNotFound
+	}
+	if err := s.checkQuota(nil, data); err != nil {
+		return nil, err
+	}
+	rev := newRevision(data, data.GetVersion(), data.GetAuthorId())
+	if err := s.commit(putRecord(data), revisionRecord(rev)); err != nil {
+		return nil, err
+	}
+	s.notify(blogpb.BlogEvent_CREATED, data)
+	return cloneBlog(data), nil
+}
+
+func (s *MemoryStore) Get(id string) (*blogpb.Blog, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, ok := s.blogs[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return cloneBlog(data), nil
+}
+
+func (s *MemoryStore) Update(blog *blogpb.Blog, editorID string) (*blogpb.Blog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.blogs[blog.GetId()]; !ok {
+		return nil, ErrNotFound
+	}
+	if err := s.checkVersion(blog.GetId(), blog.GetVersion()); err != nil {
+		return nil, err
+	}
+	if _, ok := s.authors[blog.GetAuthorId()]; !ok {
+		return nil, ErrAuthorNotFound
+	}
+	if err := s.checkQuota(s.blogs[blog.GetId()], blog); err != nil {
+		return nil, err
+	}
+	data := cloneBlog(blog)
+	data.Version = s.nextRevision(data.GetId())
+	rev := newRevision(data, data.GetVersion(), editorID)
+	if err := s.commit(putRecord(data), revisionRecord(rev)); err != nil {
+		return nil, err
+	}
+	s.notify(blogpb.BlogEvent_UPDATED, data)
+	return cloneBlog(data), nil
+}
+
+func (s *MemoryStore) Delete(id string, version int64) (*blogpb.Blog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.blogs[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if err := s.checkVersion(id, version); err != nil {
+		return nil, err
+	}
+	data = cloneBlog(data)
+	data.DeletedAt = ptypes.TimestampNow()
+	if err := s.commit(trashRecord(data)); err != nil {
+		return nil, err
+	}
+	s.notify(blogpb.BlogEvent_DELETED, data)
+	return cloneBlog(data), nil
+}
+
+func (s *MemoryStore) Restore(id string) (*blogpb.Blog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.trash[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	data = cloneBlog(data)
+	data.DeletedAt = nil
+	if err := s.commit(putRecord(data)); err != nil {
+		return nil, err
+	}
+	s.notify(blogpb.BlogEvent_RESTORED, data)
+	return cloneBlog(data), nil
+}
+
+func (s *MemoryStore) ListTrash() ([]*blogpb.Blog, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return sortedBlogs(s.trash), nil
+}
+
+func (s *MemoryStore) Purge(deletedBefore time.Time) ([]*blogpb.Blog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var purged []*blogpb.Blog
+	var recs []record
+	for _, blog := range sortedBlogs(s.trash) {
+		deletedAt, err := ptypes.Timestamp(blog.GetDeletedAt())
+		if err != nil || !deletedAt.Before(deletedBefore) {
+			continue
+		}
+		purged = append(purged, blog)
+		recs = append(recs, deleteRecord(blog.GetId()))
+	}
+	if len(recs) == 0 {
+		return nil, nil
+	}
+	if err := s.commit(recs...); err != nil {
+		return nil, err
+	}
+	return purged, nil
+}
+
+func (s *MemoryStore) List() ([]*blogpb.Blog, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return sortedBlogs(s.blogs), nil
+}
+
+func (s *MemoryStore) ListRevisions(blogID string) ([]*blogpb.BlogRevision, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, ok := s.blogs[blogID]; !ok {
+		return nil, ErrNotFound
+	}
+	revs := make([]*blogpb.BlogRevision, 0, len(s.revisions[blogID]))
+	for _, rev := range s.revisions[blogID] {
+		revs = append(revs, cloneRevision(rev))
+	}
+	return revs, nil
+}
+
+func (s *MemoryStore) GetRevision(blogID string, revision int64) (*blogpb.BlogRevision, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rev, err := s.getRevision(blogID, revision)
+	if err != nil {
+		return nil, err
+	}
+	return cloneRevision(rev), nil
+}
+
+// getRevision looks up a revision. Callers must hold the lock.
+func (s *MemoryStore) getRevision(blogID string, revision int64) (*blogpb.BlogRevision, error) {
+	if _, ok := s.blogs[blogID]; !ok {
+		return nil, ErrNotFound
+	}
+	for _, rev := range s.revisions[blogID] {
+		if rev.GetRevision() == revision {
+			return rev, nil
+		}
+	}
+	return nil, ErrRevisionNotFound
+}
+
+func (s *MemoryStore) Revert(blogID string, revision int64, editorID string) (*blogpb.BlogRevision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, err := s.getRevision(blogID, revision)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkQuota(s.blogs[blogID], old.GetBlog()); err != nil {
+		return nil, err
+	}
+	data := cloneBlog(old.GetBlog())
+	data.Version = s.nextRevision(blogID)
+	rev := newRevision(data, data.GetVersion(), editorID)
+	if err := s.commit(putRecord(data), revisionRecord(rev)); err != nil {
+		return nil, err
+	}
+	s.notify(blogpb.BlogEvent_UPDATED, data)
+	return cloneRevision(rev), nil
+}
+
+func (s *MemoryStore) AddComment(comment *blogpb.Comment) (*blogpb.Comment, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	data := cloneComment(comment)
+	data.Id = id
+	data.CreatedAt = ptypes.TimestampNow()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.blogs[data.GetBlogId()]; !ok {
+		return nil, ErrNotFound
+	}
+	if data.GetParentId() != "" && s.findComment(data.GetBlogId(), data.GetParentId()) == nil {
+		return nil, ErrCommentNotFound
+	}
+	if err := s.commit(commentRecord(data)); err != nil {
+		return nil, err
+	}
+	return cloneComment(data), nil
+}
+
+func (s *MemoryStore) ListComments(blogID string) ([]*blogpb.Comment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, ok := s.blogs[blogID]; !ok {
+		return nil, ErrNotFound
+	}
+	comments := make([]*blogpb.Comment, 0, len(s.comments[blogID]))
+	for _, c := range s.comments[blogID] {
+		comments = append(comments, cloneComment(c))
+	}
+	return comments, nil
+}
+
+func (s *MemoryStore) DeleteComment(blogID string, commentID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.blogs[blogID]; !ok {
+		return ErrNotFound
+	}
+	if s.findComment(blogID, commentID) == nil {
+		return ErrCommentNotFound
+	}
+
+	// replies always come after the comment they reply to, so a single
+	// pass finds the whole thread
+	thread := map[string]bool{commentID: true}
+	recs := []record{deleteCommentRecord(blogID, commentID)}
+	for _, c := range s.comments[blogID] {
+		if thread[c.GetParentId()] && !thread[c.GetId()] {
+			thread[c.GetId()] = true
+			recs = append(recs, deleteCommentRecord(blogID, c.GetId()))
+		}
+	}
+	return s.commit(recs...)
+}
+
+// findComment looks up a comment of a blog. Callers must hold the lock.
+func (s *MemoryStore) findComment(blogID string, commentID string) *blogpb.Comment {
+	for _, c := range s.comments[blogID] {
+		if c.GetId() == commentID {
+			return c
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) CreateAuthor(author *blogpb.Author) (*blogpb.Author, error) {
+	data := cloneAuthor(author)
+	if data.GetId() == "" {
+		id, err := newID()
+		if err != nil {
+			return nil, err
+		}
+		data.Id = id
+	}
+	data.CreatedAt = ptypes.TimestampNow()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.authors[data.GetId()]; ok {
+		return nil, ErrAuthorExists
+	}
+	if err := s.commit(authorRecord(data)); err != nil {
+		return nil, err
+	}
+	return cloneAuthor(data), nil
+}
+
+func (s *MemoryStore) GetAuthor(id string) (*blogpb.Author, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, ok := s.authors[id]
+	if !ok {
+		return nil, ErrAuthorNotFound
+	}
+	return cloneAuthor(data), nil
+}
+
+func (s *MemoryStore) ListAuthors() ([]*blogpb.Author, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return sortedAuthors(s.authors), nil
+}
+
+func (s *MemoryStore) TagCounts(statuses ...blogpb.Blog_Status) ([]*blogpb.TagCount, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	total := make(map[string]int64)
+	add := func(counts map[string]int64) {
+		for tag, count := range counts {
+			total[tag] += count
+		}
+	}
+	if len(statuses) == 0 {
+		for _, counts := range s.tags {
+			add(counts)
+		}
+	}
+	for _, st := range uniqueStatuses(statuses) {
+		add(s.tags[st])
+	}
+	return SortedTagCounts(total), nil
+}
+
+func (s *MemoryStore) SetQuota(quota Quota) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.quota = quota
+}
+
+func (s *MemoryStore) Usage(authorID string) (Usage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.usage[authorID], nil
+}
+
+// uniqueStatuses drops the statuses listed more than once
+func uniqueStatuses(statuses []blogpb.Blog_Status) []blogpb.Blog_Status {
+	seen := make(map[blogpb.Blog_Status]bool)
+	var result []blogpb.Blog_Status
+	for _, st := range statuses {
+		if !seen[st] {
+			seen[st] = true
+			result = append(result, st)
+		}
+	}
+	return result
+}
+
+func (s *MemoryStore) OnChange(f func(eventType blogpb.BlogEvent_Type, blog *blogpb.Blog)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeners = append(s.listeners, f)
+}
+
+func (s *MemoryStore) Check() error {
+	return nil
+}
+
+func (s *MemoryStore) Close() error {
+	return nil
+}
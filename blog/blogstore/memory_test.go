@@ -0,0 +1,59 @@
+package blogstore
+
+import (
+	"sync"
+	"testing"
+
+	"grpc-go-course/blog/blogpb"
+)
+
+func TestOnChangeInCommitOrder(t *testing.T) {
+	s := NewMemoryStore()
+	if _, err := s.CreateAuthor(&blogpb.Author{Id: "ann"}); err != nil {
+		t.Fatal(err)
+	}
+
+	type change struct {
+		eventType blogpb.BlogEvent_Type
+		version   int64
+	}
+	var changes []change
+	s.OnChange(func(eventType blogpb.BlogEvent_Type, blog *blogpb.Blog) {
+		changes = append(changes, change{eventType, blog.GetVersion()})
+	})
+
+	if _, err := s.Create(&blogpb.Blog{Id: "blog", AuthorId: "ann", Title: "t"}); err != nil {
+		t.Fatal(err)
+	}
+	const updates = 50
+	var wg sync.WaitGroup
+	for i := 0; i < updates; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// no version, so every update succeeds
+			if _, err := s.Update(&blogpb.Blog{Id: "blog", AuthorId: "ann", Title: "t"}, "ann"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if _, err := s.Delete("blog", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(changes) != updates+2 {
+		t.Fatalf("got %v changes, want %v", len(changes), updates+2)
+	}
+	if changes[0].eventType != blogpb.BlogEvent_CREATED {
+		t.Errorf("first change is %v, want CREATED", changes[0].eventType)
+	}
+	for i, c := range changes[1 : updates+1] {
+		if c.eventType != blogpb.BlogEvent_UPDATED || c.version != int64(i+2) {
+			t.Errorf("change %v is %v of version %v, want UPDATED of version %v", i+1, c.eventType, c.version, i+2)
+		}
+	}
+	if last := changes[len(changes)-1]; last.eventType != blogpb.BlogEvent_DELETED {
+		t.Errorf("last change is %v, want DELETED", last.eventType)
+	}
+}
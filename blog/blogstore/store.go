@@ -0,0 +1,298 @@
+package blogstore
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"grpc-go-course/blog/blogpb"
+)
+
+// ErrNotFound is returned when no blog exists with the requested id
+var ErrNotFound = errors.New("blog not found")
+
+// ErrExists is returned when creating a blog with an id already taken
+var ErrExists = errors.New("blog already exists")
+
+// ErrRevisionNotFound is returned when a blog exists but not the requested revision
+var ErrRevisionNotFound = errors.New("blog revision not found")
+
+// ErrCommentNotFound is returned when a blog exists but not the requested comment
+var ErrCommentNotFound = errors.New("comment not found")
+
+// ErrAuthorNotFound is returned when no author exists with the requested id
+var ErrAuthorNotFound = errors.New("author not found")
+
+// ErrAuthorExists is returned when creating an author with an id already taken
+var ErrAuthorExists = errors.New("author already exists")
+
+// ConflictError is returned when a blog is changed using a stale version
+type ConflictError struct {
+	BlogID         string
+	CurrentVersion int64
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("blog %v is at version %v", e.BlogID, e.CurrentVersion)
+}
+
+// Quota limits what each author can store. Blogs in the trash count until
+// they are purged. A limit of 0 means no limit.
+type Quota struct {
+	// MaxBlogs is the largest number of blogs of an author
+	MaxBlogs int64
+	// MaxBytes is the largest total size of the titles and contents of
+	// the blogs of an author
+	MaxBytes int64
+}
+
+// Usage is what an author stores
+type Usage struct {
+	Blogs int64
+	Bytes int64
+}
+
+// QuotaError is returned when a change would take an author over quota
+type QuotaError struct {
+	AuthorID string
+	// Resource is "blogs" or "bytes"
+	Resource string
+	Limit    int64
+	// Usage is what the author would store after the change
+	Usage int64
+}
+
+func (e *QuotaError) Error() string {
+	return fmt.Sprintf("author %v would store %v %v, over the quota of %v", e.AuthorID, e.Usage, e.Resource, e.Limit)
+}
+
+// BlogStore persists blogs for the blog server.
+// Implementations must be safe for concurrent use and must never hand out
+// pointers to the blogs they hold internally.
+type BlogStore interface {
+	// Create stores a new blog and returns it with version 1 and a freshly
+	// assigned id, unless blog.Id is set in which case ErrExists is returned
+	// if the id is taken. The author is recorded as the editor of the first
+	// revision. ErrAuthorNotFound is returned if the author does not exist.
+	Create(blog *blogpb.Blog) (*blogpb.Blog, error)
+
+	// Get returns the blog with the given id or ErrNotFound
+	Get(id string) (*blogpb.Blog, error)
+
+	// Update replaces the blog with the same id and records a new revision,
+	// or returns ErrNotFound. Unless blog.Version is 0 it must match the
+	// stored version or a *ConflictError is returned.
+	// ErrAuthorNotFound is returned if the author does not exist.
+	Update(blog *blogpb.Blog, editorID string) (*blogpb.Blog, error)
+
+	// Delete moves the blog with the given id to the trash and returns it
+	// with DeletedAt set, or returns ErrNotFound. Unless version is 0 it
+	// must match the stored version or a *ConflictError is returned.
+	// Blogs in the trash are only seen by ListTrash, Restore and Purge.
+	Delete(id string, version int64) (*blogpb.Blog, error)
+
+	// Restore takes a blog out of the trash and returns it, or returns
+	// ErrNotFound if the blog is not in the trash
+	Restore(id string) (*blogpb.Blog, error)
+
+	// ListTrash returns every blog in the trash ordered by id
+	ListTrash() ([]*blogpb.Blog, error)
+
+	// Purge removes for good the blogs deleted before the given time, with
+	// their revisions and comments, and returns them
+	Purge(deletedBefore time.Time) ([]*blogpb.Blog, error)
+
+	// List returns every stored blog ordered by id, without the trash
+	List() ([]*blogpb.Blog, error)
+
+	// ListRevisions returns the revisions of a blog, oldest first
+	ListRevisions(blogID string) ([]*blogpb.BlogRevision, error)
+
+	// GetRevision returns one revision of a blog
+	GetRevision(blogID string, revision int64) (*blogpb.BlogRevision, error)
+
+	// Revert restores the content of an old revision as a new revision
+	Revert(blogID string, revision int64, editorID string) (*blogpb.BlogRevision, error)
+
+	// AddComment stores a new comment and returns it with a freshly
+	// assigned id. The blog and the parent comment, if any, must exist.
+	AddComment(comment *blogpb.Comment) (*blogpb.Comment, error)
+
+	// ListComments returns the comments of a blog, oldest first
+	ListComments(blogID string) ([]*blogpb.Comment, error)
+
+	// DeleteComment removes a comment and all its replies
+	DeleteComment(blogID string, commentID string) error
+
+	// CreateAuthor stores a new author profile, a random id is assigned if
+	// author.Id is empty. ErrAuthorExists is returned if the id is taken.
+	CreateAuthor(author *blogpb.Author) (*blogpb.Author, error)
+
+	// GetAuthor returns the author with the given id or ErrAuthorNotFound
+	GetAuthor(id string) (*blogpb.Author, error)
+
+	// ListAuthors returns every author ordered by id
+	ListAuthors() ([]*blogpb.Author, error)
+
+	// SetQuota changes the quota checked by Create, Update and Revert,
+	// which return a *QuotaError when an author would go over it.
+	// Changes that don't make an author store more are always allowed.
+	SetQuota(quota Quota)
+
+	// Usage returns what an author stores
+	Usage(authorID string) (Usage, error)
+
+	// TagCounts returns how many blogs in one of the given statuses use each
+	// tag, most used first. Every status is counted if none is given.
+	TagCounts(statuses ...blogpb.Blog_Status) ([]*blogpb.TagCount, error)
+
+	// OnChange registers f to be given every blog created, changed,
+	// deleted or restored, in the order of the changes. f is called with
+	// the store locked, so it must return quickly and must not use the
+	// store.
+	OnChange(f func(eventType blogpb.BlogEvent_Type, blog *blogpb.Blog))
+
+	// Check returns an error when the store cannot keep the changes made
+	// to it
+	Check() error
+
+	// Close releases any resource held by the store
+	Close() error
+}
+
+const (
+	opPut      = "put"
+	opTrash    = "trash"
+	opDelete   = "delete"
+	opRevision = "revision"
+
+	opComment       = "comment"
+	opDeleteComment = "delete_comment"
+
+	opAuthor = "author"
+)
+
+// record is a single change to the content of a store.
+// Every change goes through records so the file store can journal them.
+type record struct {
+	op       string
+	id       string
+	blog     *blogpb.Blog
+	revision *blogpb.BlogRevision
+	comment  *blogpb.Comment
+	author   *blogpb.Author
+}
+
+func putRecord(blog *blogpb.Blog) record {
+	return record{op: opPut, id: blog.GetId(), blog: blog}
+}
+
+func trashRecord(blog *blogpb.Blog) record {
+	return record{op: opTrash, id: blog.GetId(), blog: blog}
+}
+
+func deleteRecord(id string) record {
+	return record{op: opDelete, id: id}
+}
+
+func revisionRecord(rev *blogpb.BlogRevision) record {
+	return record{op: opRevision, id: rev.GetBlogId(), revision: rev}
+}
+
+func commentRecord(comment *blogpb.Comment) record {
+	return record{op: opComment, id: comment.GetBlogId(), comment: comment}
+}
+
+func deleteCommentRecord(blogID string, commentID string) record {
+	return record{op: opDeleteComment, id: blogID, comment: &blogpb.Comment{Id: commentID, BlogId: blogID}}
+}
+
+func authorRecord(author *blogpb.Author) record {
+	return record{op: opAuthor, id: author.GetId(), author: author}
+}
+
+// newID returns a random hex string used as the id of a new blog
+func newID() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// newRevision returns a revision holding a copy of blog created now
+func newRevision(blog *blogpb.Blog, revision int64, editorID string) *blogpb.BlogRevision {
+	return &blogpb.BlogRevision{
+		BlogId:    blog.GetId(),
+		Revision:  revision,
+		Blog:      cloneBlog(blog),
+		EditorId:  editorID,
+		CreatedAt: ptypes.TimestampNow(),
+	}
+}
+
+// blogSize is the size of a blog counted against the quota
+func blogSize(b *blogpb.Blog) int64 {
+	return int64(len(b.GetTitle()) + len(b.GetContent()))
+}
+
+func cloneBlog(b *blogpb.Blog) *blogpb.Blog {
+	return proto.Clone(b).(*blogpb.Blog)
+}
+
+func cloneRevision(r *blogpb.BlogRevision) *blogpb.BlogRevision {
+	return proto.Clone(r).(*blogpb.BlogRevision)
+}
+
+func cloneComment(c *blogpb.Comment) *blogpb.Comment {
+	return proto.Clone(c).(*blogpb.Comment)
+}
+
+func cloneAuthor(a *blogpb.Author) *blogpb.Author {
+	return proto.Clone(a).(*blogpb.Author)
+}
+
+// SortedTagCounts turns tag counts into a slice, most used tag first and
+// tags used as often in alphabetical order
+func SortedTagCounts(counts map[string]int64) []*blogpb.TagCount {
+	result := make([]*blogpb.TagCount, 0, len(counts))
+	for tag, count := range counts {
+		result = append(result, &blogpb.TagCount{Tag: tag, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].GetCount() != result[j].GetCount() {
+			return result[i].GetCount() > result[j].GetCount()
+		}
+		return result[i].GetTag() < result[j].GetTag()
+	})
+	return result
+}
+
+// sortedAuthors copies the authors of m into a slice ordered by id
+func sortedAuthors(m map[string]*blogpb.Author) []*blogpb.Author {
+	authors := make([]*blogpb.Author, 0, len(m))
+	for _, a := range m {
+		authors = append(authors, cloneAuthor(a))
+	}
+	sort.Slice(authors, func(i, j int) bool {
+		return authors[i].GetId() < authors[j].GetId()
+	})
+	return authors
+}
+
+// sortedBlogs copies the blogs of m into a slice ordered by id
+func sortedBlogs(m map[string]*blogpb.Blog) []*blogpb.Blog {
+	blogs := make([]*blogpb.Blog, 0, len(m))
+	for _, b := range m {
+		blogs = append(blogs, cloneBlog(b))
+	}
+	sort.Slice(blogs, func(i, j int) bool {
+		return blogs[i].GetId() < blogs[j].GetId()
+	})
+	return blogs
+}
@@ -0,0 +1,15 @@
+package blogvalidate
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor rejects invalid requests before they reach the
+// handlers
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := Error(Request(req)); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
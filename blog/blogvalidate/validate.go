@@ -0,0 +1,151 @@
+package blogvalidate
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc-go-course/blog/blogpb"
+)
+
+// rule describes the constraints on one field of a blog. Repeated fields
+// are checked element by element.
+type rule struct {
+	// field is the name of the field in blog.proto
+	field string
+	// values returns the values of the field
+	values func(blog *blogpb.Blog) []string
+	// required fields must hold at least one non-empty value
+	required bool
+	// maxLength is the largest number of characters of a value
+	maxLength int
+	// maxCount is the largest number of values of a repeated field, 0 if
+	// the field is not repeated
+	maxCount int
+}
+
+// blogRules are the constraints every blog sent by a client must satisfy
+var blogRules = []rule{
+	{
+		field:     "id",
+		values:    func(b *blogpb.Blog) []string { return []string{b.GetId()} },
+		maxLength: 64,
+	},
+	{
+		field:     "author_id",
+		values:    func(b *blogpb.Blog) []string { return []string{b.GetAuthorId()} },
+		required:  true,
+		maxLength: 64,
+	},
+	{
+		field:     "title",
+		values:    func(b *blogpb.Blog) []string { return []string{b.GetTitle()} },
+		required:  true,
+		maxLength: 200,
+	},
+	{
+		field:     "content",
+		values:    func(b *blogpb.Blog) []string { return []string{b.GetContent()} },
+		maxLength: 100000,
+	},
+	{
+		field:     "tags",
+		values:    func(b *blogpb.Blog) []string { return b.GetTags() },
+		maxLength: 50,
+		maxCount:  20,
+	},
+}
+
+// Blog returns the violations of the rules by the fields of blog listed
+// in fields, or by every field if fields is empty. Field names are
+// reported with prefix prepended, like "blog.".
+func Blog(blog *blogpb.Blog, prefix string, fields []string) []*errdetails.BadRequest_FieldViolation {
+	listed := make(map[string]bool)
+	for _, field := range fields {
+		listed[field] = true
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	add := func(field string, format string, args ...interface{}) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       prefix + field,
+			Description: fmt.Sprintf(format, args...),
+		})
+	}
+
+	for _, r := range blogRules {
+		if len(listed) > 0 && !listed[r.field] {
+			continue
+		}
+		values := r.values(blog)
+		if r.maxCount > 0 && len(values) > r.maxCount {
+			add(r.field, "must not have more than %v values", r.maxCount)
+		}
+		if r.required && (len(values) == 0 || strings.TrimSpace(values[0]) == "") {
+			add(r.field, "is required")
+		}
+		for i, value := range values {
+			field := r.field
+			if r.maxCount > 0 {
+				field = fmt.Sprintf("%v[%v]", r.field, i)
+			}
+			if !utf8.ValidString(value) {
+				add(field, "must be valid UTF-8")
+				continue
+			}
+			if n := utf8.RuneCountInString(value); n > r.maxLength {
+				add(field, "must not be longer than %v characters, got %v", r.maxLength, n)
+			}
+		}
+	}
+	return violations
+}
+
+// Request returns the violations of the rules by a request of the blog
+// service. Requests without blogs never violate any rule.
+func Request(req interface{}) []*errdetails.BadRequest_FieldViolation {
+	switch req := req.(type) {
+	case *blogpb.CreateBlogRequest:
+		if req.GetBlog() == nil {
+			return []*errdetails.BadRequest_FieldViolation{{
+				Field:       "blog",
+				Description: "is required",
+			}}
+		}
+		return Blog(req.GetBlog(), "blog.", nil)
+	case *blogpb.UpdateBlogRequest:
+		if req.GetBlog() == nil {
+			return []*errdetails.BadRequest_FieldViolation{{
+				Field:       "blog",
+				Description: "is required",
+			}}
+		}
+		// partial updates only change, so only check, the listed fields
+		return Blog(req.GetBlog(), "blog.", req.GetUpdateMask().GetPaths())
+	}
+	return nil
+}
+
+// Error returns an INVALID_ARGUMENT status with a BadRequest detail
+// listing the violations, or nil if there are none
+func Error(violations []*errdetails.BadRequest_FieldViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	msg := fmt.Sprintf("Invalid request: %v %v", violations[0].GetField(), violations[0].GetDescription())
+	if len(violations) > 1 {
+		msg += fmt.Sprintf(" (and %v more)", len(violations)-1)
+	}
+	st := status.New(codes.InvalidArgument, msg)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: violations,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -2,20 +2,35 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"grpc-go-course/calculator/calculatorpb"
+	"grpc-go-course/config"
 	"io"
 	"log"
+	"os"
 	"time"
 )
 
 func main() {
 
+	cfg := config.Client{
+		Address:  "localhost:50051",
+		CAFile:   "ssl/ca.crt",
+		LogLevel: "warning",
+	}
+	cfg.Register(flag.CommandLine)
+	if err := config.Load(flag.CommandLine, os.Args[1:], "CALCULATOR_CLIENT_"); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if err := config.SetupLogging(cfg.LogLevel); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
 	fmt.Println("Calculator client")
-	cc, err := grpc.Dial("localhost:50051", grpc.WithInsecure())
+	cc, err := cfg.Dial()
 	if err != nil {
 		log.Fatalf("Couldn't connect: %v", err)
 	}
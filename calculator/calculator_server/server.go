@@ -1,139 +1,56 @@
 package main
 
 import (
+	"flag"
 	"fmt"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-	"grpc-go-course/calculator/calculatorpb"
-	"io"
+	"grpc-go-course/calculator/calculatorservice"
+	"grpc-go-course/config"
+	"grpc-go-course/runner"
 	"log"
-	"math"
-	"net"
+	"os"
+	"time"
 )
 
-type server struct{}
-
-func (*server) Sum(c context.Context, req *calculatorpb.SumRequest) (*calculatorpb.SumResponse, error){
-
-	fmt.Println("Received Sum RPC %v", req)
-	firstNumber := req.GetFirstNumber()
-	secondNumber := req.GetSecondNumber()
-	sum := firstNumber + secondNumber
-	res := &calculatorpb.SumResponse{
-		SumResult: sum,
-	}
-	return res, nil
-}
-
-func (*server) PrimeNumberDecomposition(req *calculatorpb.PrimeNumberDecompositionRequest, stream calculatorpb.CalculatorService_PrimeNumberDecompositionServer) error {
-
-	fmt.Println("Received PrimeNumberDecomposition RPC %v", req)
-	number := req.GetNumber()
-
-	divisor := int64(2)
-	for number > 1 {
-		if number % divisor  == 0 {
-			res := &calculatorpb.PrimeNumberDecompositionResponse{
-				PrimeFactor: divisor,
-			}
-			stream.Send(res)
-			number = number / divisor
-		}else{
-			divisor++
-			fmt.Println("Divisor has increased to ", divisor)
-		}
-	}
-	return nil
-}
-
-func (*server) ComputeAverage(stream calculatorpb.CalculatorService_ComputeAverageServer) error {
-
-	fmt.Println("Received ComputeAverage RPC")
-
-	sum := int32(0)
-	count := 0
-
-	for {
-		req, err := stream.Recv()
-		if err == io.EOF {
-			average :=  float64(sum)/float64(count)
-			stream.SendAndClose(&calculatorpb.ComputeAverageResponse{
-				Average: average,
-			})
-			break
-		}
-
-		if err != nil {
-			log.Fatalf("Error while reading client stream: %v", err)
-		}
-
-		sum += req.GetNumber()
-		count++
+func main() {
+	cfg := config.Server{
+		Listen:       "0.0.0.0:50051",
+		CertFile:     "ssl/server.crt",
+		KeyFile:      "ssl/server.pem",
+		DrainDelay:   5 * time.Second,
+		DrainTimeout: 15 * time.Second,
+		LogLevel:     "warning",
 	}
-	return nil
-}
-
-func (*server) FindMaximum(stream calculatorpb.CalculatorService_FindMaximumServer) error {
-
-	fmt.Println("Received FindMaximum RPC")
-	maximum := int32(0)
-
-	for {
-		req, err := stream.Recv()
-		if err == io.EOF {
-			return nil
-		}
-
-		if err != nil {
-			log.Fatalf("Error while reading client stream: %v", err)
-			return err
-		}
-
-		number := req.GetNumber()
-		if number > maximum {
-			maximum = number
-			sendErr := stream.Send(&calculatorpb.FindMaximumResponse{
-				MaximumNumber: maximum,
-			})
-
-			if sendErr != nil {
-				log.Fatalf("Error while  sending data to client: %v", sendErr)
-				return sendErr
-			}
-		}
+	cfg.Register(flag.CommandLine)
+	if err := config.Load(flag.CommandLine, os.Args[1:], "CALCULATOR_SERVER_"); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
 	}
-}
-
-func (*server) SquareRoot(ctx context.Context, req *calculatorpb.SquareRootRequest) (*calculatorpb.SquareRootResponse, error) {
-
-	fmt.Println("Received SquareRoot RPC")
-	number := req.GetNumber()
-
-	if number < 0 {
-		return nil, status.Errorf(
-			codes.InvalidArgument,
-			fmt.Sprintf("Received a negative number: %v", number),
-		)
+	if err := config.SetupLogging(cfg.LogLevel); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
 	}
-	return &calculatorpb.SquareRootResponse{
-		NumberRoot: math.Sqrt(float64(number)),
-	}, nil
-}
 
-func main() {
 	fmt.Println("Calculator Server")
 
-	lis, err := net.Listen("tcp", "0.0.0.0:50051")
+	lis, err := cfg.NewListener()
 	if err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
 
-	s := grpc.NewServer()
-	calculatorpb.RegisterCalculatorServiceServer(s, &server{})
+	r := runner.New(cfg.DrainDelay, cfg.DrainTimeout)
+
+	opts, sslErr := cfg.ServerOptions()
+	if sslErr != nil {
+		log.Fatalf("Failed loading certificates : %v", sslErr)
+	}
+	opts = append(opts, r.ServerOptions()...)
+
+	s := grpc.NewServer(opts...)
+	calculatorservice.Register(s)
+	r.RegisterHealth(s)
+	cfg.RegisterReflection(s)
 
-	if err := s.Serve(lis); err != nil {
+	if err := r.Run(s, lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
+	fmt.Println("End of program")
 }
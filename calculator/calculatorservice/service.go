@@ -0,0 +1,132 @@
+// Package calculatorservice implements the calculator service.
+package calculatorservice
+
+import (
+	"fmt"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc-go-course/calculator/calculatorpb"
+	"io"
+	"log"
+	"math"
+)
+
+// Server implements the calculator service
+type Server struct{}
+
+func (*Server) Sum(c context.Context, req *calculatorpb.SumRequest) (*calculatorpb.SumResponse, error){
+
+	fmt.Println("Received Sum RPC %v", req)
+	firstNumber := req.GetFirstNumber()
+	secondNumber := req.GetSecondNumber()
+	sum := firstNumber + secondNumber
+	res := &calculatorpb.SumResponse{
+		SumResult: sum,
+	}
+	return res, nil
+}
+
+func (*Server) PrimeNumberDecomposition(req *calculatorpb.PrimeNumberDecompositionRequest, stream calculatorpb.CalculatorService_PrimeNumberDecompositionServer) error {
+
+	fmt.Println("Received PrimeNumberDecomposition RPC %v", req)
+	number := req.GetNumber()
+
+	divisor := int64(2)
+	for number > 1 {
+		if number % divisor  == 0 {
+			res := &calculatorpb.PrimeNumberDecompositionResponse{
+				PrimeFactor: divisor,
+			}
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+			number = number / divisor
+		}else{
+			divisor++
+			fmt.Println("Divisor has increased to ", divisor)
+		}
+	}
+	return nil
+}
+
+func (*Server) ComputeAverage(stream calculatorpb.CalculatorService_ComputeAverageServer) error {
+
+	fmt.Println("Received ComputeAverage RPC")
+
+	sum := int32(0)
+	count := 0
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			average :=  float64(sum)/float64(count)
+			stream.SendAndClose(&calculatorpb.ComputeAverageResponse{
+				Average: average,
+			})
+			break
+		}
+
+		if err != nil {
+			log.Printf("Error while reading client stream: %v", err)
+			return err
+		}
+
+		sum += req.GetNumber()
+		count++
+	}
+	return nil
+}
+
+func (*Server) FindMaximum(stream calculatorpb.CalculatorService_FindMaximumServer) error {
+
+	fmt.Println("Received FindMaximum RPC")
+	maximum := int32(0)
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			log.Printf("Error while reading client stream: %v", err)
+			return err
+		}
+
+		number := req.GetNumber()
+		if number > maximum {
+			maximum = number
+			sendErr := stream.Send(&calculatorpb.FindMaximumResponse{
+				MaximumNumber: maximum,
+			})
+
+			if sendErr != nil {
+				log.Printf("Error while  sending data to client: %v", sendErr)
+				return sendErr
+			}
+		}
+	}
+}
+
+func (*Server) SquareRoot(ctx context.Context, req *calculatorpb.SquareRootRequest) (*calculatorpb.SquareRootResponse, error) {
+
+	fmt.Println("Received SquareRoot RPC")
+	number := req.GetNumber()
+
+	if number < 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Received a negative number: %v", number),
+		)
+	}
+	return &calculatorpb.SquareRootResponse{
+		NumberRoot: math.Sqrt(float64(number)),
+	}, nil
+}
+
+// Register registers the calculator service on s
+func Register(s *grpc.Server) {
+	calculatorpb.RegisterCalculatorServiceServer(s, &Server{})
+}
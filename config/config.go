@@ -0,0 +1,173 @@
+// Package config loads the settings of the servers and clients.
+//
+// Settings are declared as flags. A flag that is not given on the command
+// line takes the value of its environment variable, named after the flag
+// with a prefix, like BLOG_SERVER_DRAIN_TIMEOUT for -drain-timeout, or else
+// the value found in the config file, or else its default.
+//
+// The config file is given with -config or the CONFIG environment variable
+// (BLOG_SERVER_CONFIG for example). It is read as TOML if its name ends in
+// .toml and as YAML otherwise. Its keys are the flag names, "_" can be used
+// instead of "-", and nested tables are joined to their keys with "-":
+//
+//	listen: 0.0.0.0:50051
+//	drain_timeout: 30s
+//	log:
+//	  level: debug
+//
+// sets -listen, -drain-timeout and -log-level. Keys that are
+// not flags of the program are ignored so one file can be shared by
+// several programs, like the commands of a client.
+package config
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v2"
+)
+
+// configFlag is the flag naming the config file
+const configFlag = "config"
+
+// Load parses args into fs, then sets the flags not given in args from the
+// environment variables starting with envPrefix or from the config file
+func Load(fs *flag.FlagSet, args []string, envPrefix string) error {
+	_, err := LoadGiven(fs, args, envPrefix)
+	return err
+}
+
+// LoadGiven is Load that also returns the names of the flags given in args.
+// fs.Visit can't tell them apart from the flags set from the environment
+// or the config file.
+func LoadGiven(fs *flag.FlagSet, args []string, envPrefix string) (map[string]bool, error) {
+	if fs.Lookup(configFlag) == nil {
+		fs.String(configFlag, "", fmt.Sprintf("YAML or TOML file with the settings, also read from $%v", envName(envPrefix, configFlag)))
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	given := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		given[f.Name] = true
+	})
+
+	path := fs.Lookup(configFlag).Value.String()
+	if !given[configFlag] {
+		if env, ok := os.LookupEnv(envName(envPrefix, configFlag)); ok {
+			path = env
+		}
+	}
+	var file map[string]string
+	if path != "" {
+		var err error
+		file, err = readFile(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		if err != nil || given[f.Name] || f.Name == configFlag {
+			return
+		}
+		env := envName(envPrefix, f.Name)
+		if value, ok := os.LookupEnv(env); ok {
+			if setErr := fs.Set(f.Name, value); setErr != nil {
+				err = fmt.Errorf("invalid value %q for $%v: %v", value, env, setErr)
+			}
+			return
+		}
+		if value, ok := file[keyName(f.Name)]; ok {
+			if setErr := fs.Set(f.Name, value); setErr != nil {
+				err = fmt.Errorf("invalid value %q for %v in %v: %v", value, f.Name, path, setErr)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return given, nil
+}
+
+// envName returns the environment variable of a flag
+func envName(prefix string, flagName string) string {
+	return prefix + strings.ToUpper(strings.Replace(flagName, "-", "_", -1))
+}
+
+// keyName returns the normalized form of a flag name or of a config file key
+func keyName(name string) string {
+	return strings.ToLower(strings.Replace(name, "_", "-", -1))
+}
+
+// readFile reads a config file into a map from normalized keys to values
+func readFile(path string) (map[string]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var content map[string]interface{}
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		err = toml.Unmarshal(data, &content)
+	} else {
+		err = yaml.Unmarshal(data, &content)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %v: %v", path, err)
+	}
+
+	values := make(map[string]string)
+	if err := flatten(values, "", content); err != nil {
+		return nil, fmt.Errorf("cannot read %v: %v", path, err)
+	}
+	return values, nil
+}
+
+// flatten turns the nested tables of a config file into flag values
+func flatten(values map[string]string, prefix string, value interface{}) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, inner := range v {
+			if err := flatten(values, joinKey(prefix, key), inner); err != nil {
+				return err
+			}
+		}
+	case map[interface{}]interface{}:
+		// YAML tables below the top level
+		for key, inner := range v {
+			if err := flatten(values, joinKey(prefix, fmt.Sprint(key)), inner); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		// lists are given to flags as comma separated values
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = fmt.Sprint(item)
+		}
+		values[prefix] = strings.Join(items, ",")
+	case nil:
+		// an empty key keeps the default
+	default:
+		if prefix == "" {
+			return fmt.Errorf("expected a table of settings")
+		}
+		values[prefix] = fmt.Sprint(v)
+	}
+	return nil
+}
+
+func joinKey(prefix string, key string) string {
+	if prefix == "" {
+		return keyName(key)
+	}
+	return prefix + "-" + keyName(key)
+}
@@ -0,0 +1,199 @@
+package config
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testPrefix = "CONFIG_TEST_"
+
+// setEnv sets the environment variables of env until the end of the test
+func setEnv(t *testing.T, env map[string]string) {
+	for name, value := range env {
+		name := testPrefix + name
+		if err := os.Setenv(name, value); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { os.Unsetenv(name) })
+	}
+}
+
+// writeFile writes a config file named name in a new temporary directory
+// and returns its path
+func writeFile(t *testing.T, name string, content string) string {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	const file = "listen: file:1\ndrain_timeout: 3s\nlog:\n  level: warn\n"
+
+	tests := []struct {
+		name string
+		args []string
+		env  map[string]string
+		// file is the content of the YAML config file, if any
+		file        string
+		wantListen  string
+		wantTimeout time.Duration
+		wantLevel   string
+	}{
+		{
+			name:        "defaults",
+			wantListen:  "default:1",
+			wantTimeout: time.Second,
+			wantLevel:   "info",
+		},
+		{
+			name:        "file over defaults",
+			file:        file,
+			wantListen:  "file:1",
+			wantTimeout: 3 * time.Second,
+			wantLevel:   "warn",
+		},
+		{
+			name:        "env over file",
+			env:         map[string]string{"LISTEN": "env:1", "LOG_LEVEL": "debug"},
+			file:        file,
+			wantListen:  "env:1",
+			wantTimeout: 3 * time.Second,
+			wantLevel:   "debug",
+		},
+		{
+			name:        "flags over env and file",
+			args:        []string{"-listen", "flag:1", "-drain-timeout", "5s"},
+			env:         map[string]string{"LISTEN": "env:1", "DRAIN_TIMEOUT": "4s"},
+			file:        file,
+			wantListen:  "flag:1",
+			wantTimeout: 5 * time.Second,
+			wantLevel:   "warn",
+		},
+		{
+			name:        "flag set to its default over env",
+			args:        []string{"-listen", "default:1"},
+			env:         map[string]string{"LISTEN": "env:1"},
+			wantListen:  "default:1",
+			wantTimeout: time.Second,
+			wantLevel:   "info",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEnv(t, tt.env)
+			args := tt.args
+			if tt.file != "" {
+				args = append([]string{"-config", writeFile(t, "config.yaml", tt.file)}, args...)
+			}
+
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			listen := fs.String("listen", "default:1", "")
+			timeout := fs.Duration("drain-timeout", time.Second, "")
+			level := fs.String("log-level", "info", "")
+			if err := Load(fs, args, testPrefix); err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+
+			if *listen != tt.wantListen {
+				t.Errorf("listen = %q, want %q", *listen, tt.wantListen)
+			}
+			if *timeout != tt.wantTimeout {
+				t.Errorf("drain-timeout = %v, want %v", *timeout, tt.wantTimeout)
+			}
+			if *level != tt.wantLevel {
+				t.Errorf("log-level = %q, want %q", *level, tt.wantLevel)
+			}
+		})
+	}
+}
+
+func TestLoadConfigPath(t *testing.T) {
+	yamlPath := writeFile(t, "config.yaml", "listen: yaml:1\n")
+	tomlPath := writeFile(t, "config.toml", "[log]\nlevel = \"error\"\n")
+
+	tests := []struct {
+		name       string
+		args       []string
+		env        map[string]string
+		wantListen string
+		wantLevel  string
+	}{
+		{
+			name:       "file from env",
+			env:        map[string]string{"CONFIG": yamlPath},
+			wantListen: "yaml:1",
+			wantLevel:  "info",
+		},
+		{
+			name:       "file from flag over env",
+			args:       []string{"-config", tomlPath},
+			env:        map[string]string{"CONFIG": yamlPath},
+			wantListen: "default:1",
+			wantLevel:  "error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEnv(t, tt.env)
+
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			listen := fs.String("listen", "default:1", "")
+			level := fs.String("log-level", "info", "")
+			if err := Load(fs, tt.args, testPrefix); err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+
+			if *listen != tt.wantListen {
+				t.Errorf("listen = %q, want %q", *listen, tt.wantListen)
+			}
+			if *level != tt.wantLevel {
+				t.Errorf("log-level = %q, want %q", *level, tt.wantLevel)
+			}
+		})
+	}
+}
+
+func TestLoadInvalidValue(t *testing.T) {
+	setEnv(t, map[string]string{"DRAIN_TIMEOUT": "soon"})
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Duration("drain-timeout", time.Second, "")
+	if err := Load(fs, nil, testPrefix); err == nil {
+		t.Error("Load accepted an invalid duration from the environment")
+	}
+}
+
+func TestLoadGivenOnlyCommandLine(t *testing.T) {
+	setEnv(t, map[string]string{"TAGS": "env"})
+	path := writeFile(t, "config.yaml", "author: ann\ntitle: shared\n")
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	author := fs.String("author", "", "")
+	fs.String("title", "", "")
+	tags := fs.String("tags", "", "")
+	given, err := LoadGiven(fs, []string{"-config", path, "-title", "given"}, testPrefix)
+	if err != nil {
+		t.Fatalf("LoadGiven: %v", err)
+	}
+
+	if *author != "ann" || *tags != "env" {
+		t.Errorf("author, tags = %q, %q, want the values of the file and the environment", *author, *tags)
+	}
+	want := map[string]bool{"config": true, "title": true}
+	if len(given) != len(want) || !given["config"] || !given["title"] {
+		t.Errorf("given = %v, want %v", given, want)
+	}
+}
@@ -0,0 +1,119 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
+)
+
+// Server holds the settings shared by the servers
+type Server struct {
+	// Listen is the address the server listens on
+	Listen string
+	// TLS makes the server use CertFile and KeyFile
+	TLS      bool
+	CertFile string
+	KeyFile  string
+	// DrainDelay is how long the server keeps accepting calls after
+	// reporting it is stopping, so health checkers notice in time
+	DrainDelay time.Duration
+	// DrainTimeout is how long calls in progress can run when stopping
+	DrainTimeout time.Duration
+	LogLevel     string
+	// Reflection serves the reflection service, which describes the other
+	// services to tools that don't have their .proto files
+	Reflection bool
+}
+
+// Register declares the settings as flags of fs, with the values of c as
+// defaults
+func (c *Server) Register(fs *flag.FlagSet) {
+	fs.StringVar(&c.Listen, "listen", c.Listen, "address to listen on")
+	fs.BoolVar(&c.TLS, "tls", c.TLS, "serve with TLS")
+	fs.StringVar(&c.CertFile, "cert", c.CertFile, "certificate used with -tls")
+	fs.StringVar(&c.KeyFile, "key", c.KeyFile, "private key of the certificate used with -tls")
+	fs.DurationVar(&c.DrainDelay, "drain-delay", c.DrainDelay, "how long to keep accepting calls after reporting the server as not serving when stopping")
+	fs.DurationVar(&c.DrainTimeout, "drain-timeout", c.DrainTimeout, "how long calls in progress can run when stopping")
+	fs.StringVar(&c.LogLevel, "log-level", c.LogLevel, "log level: debug, info, warning or error")
+	fs.BoolVar(&c.Reflection, "reflection", c.Reflection, "serve the reflection service describing the other services")
+}
+
+// RegisterReflection registers the reflection service on s when it is
+// turned on
+func (c *Server) RegisterReflection(s *grpc.Server) {
+	if c.Reflection {
+		reflection.Register(s)
+	}
+}
+
+// NewListener listens on the configured address
+func (c *Server) NewListener() (net.Listener, error) {
+	return net.Listen("tcp", c.Listen)
+}
+
+// ServerOptions returns the options of a server with the configured
+// credentials
+func (c *Server) ServerOptions() ([]grpc.ServerOption, error) {
+	if !c.TLS {
+		return nil, nil
+	}
+	creds, err := credentials.NewServerTLSFromFile(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load certificates: %v", err)
+	}
+	return []grpc.ServerOption{grpc.Creds(creds)}, nil
+}
+
+// Client holds the settings shared by the clients
+type Client struct {
+	// Address is the address of the server
+	Address string
+	// TLS makes the client check the server certificate against CAFile
+	TLS    bool
+	CAFile string
+	// Timeout is the deadline of unary calls, no deadline if 0
+	Timeout  time.Duration
+	LogLevel string
+}
+
+// Register declares the settings as flags of fs, with the values of c as
+// defaults
+func (c *Client) Register(fs *flag.FlagSet) {
+	fs.StringVar(&c.Address, "address", c.Address, "address of the server")
+	fs.BoolVar(&c.TLS, "tls", c.TLS, "use TLS to connect to the server")
+	fs.StringVar(&c.CAFile, "ca", c.CAFile, "Certificate Authority trust certificate used with -tls")
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, "deadline of each call, except streams, no deadline if 0")
+	fs.StringVar(&c.LogLevel, "log-level", c.LogLevel, "log level: debug, info, warning or error")
+}
+
+// Dial connects to the configured server
+func (c *Client) Dial() (*grpc.ClientConn, error) {
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if c.TLS {
+		creds, err := credentials.NewClientTLSFromFile(c.CAFile, "")
+		if err != nil {
+			return nil, fmt.Errorf("cannot load CA trust certificate: %v", err)
+		}
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	}
+	if c.Timeout > 0 {
+		opts = append(opts, grpc.WithUnaryInterceptor(c.withTimeout))
+	}
+	return grpc.Dial(c.Address, opts...)
+}
+
+// withTimeout gives the configured deadline to the unary calls without one
+func (c *Client) withTimeout(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
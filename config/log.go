@@ -0,0 +1,33 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"google.golang.org/grpc/grpclog"
+)
+
+// SetupLogging applies a log level to the standard logger and to the
+// internal logs of gRPC. The debug level also logs file names and lines.
+func SetupLogging(level string) error {
+	info, warning, errors := ioutil.Discard, ioutil.Discard, ioutil.Discard
+	verbosity := 0
+	switch level {
+	case "debug":
+		verbosity = 2
+		log.SetFlags(log.LstdFlags | log.Lshortfile)
+		info, warning, errors = os.Stderr, os.Stderr, os.Stderr
+	case "info":
+		info, warning, errors = os.Stderr, os.Stderr, os.Stderr
+	case "warning":
+		warning, errors = os.Stderr, os.Stderr
+	case "error":
+		errors = os.Stderr
+	default:
+		return fmt.Errorf("unknown log level %q", level)
+	}
+	grpclog.SetLoggerV2(grpclog.NewLoggerV2WithVerbosity(info, warning, errors, verbosity))
+	return nil
+}
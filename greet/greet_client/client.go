@@ -2,37 +2,37 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
+	"grpc-go-course/config"
 	"grpc-go-course/greet/greetpb"
 	"io"
 	"log"
+	"os"
 	"time"
 )
 
 func main() {
 
-	fmt.Println("Hello, I'm a client")
-
-	tls := true
-	opts := grpc.WithInsecure()
-
-	if tls {
-		certFile := "ssl/ca.crt" // Certificate Authority Trust Certificate
-		creds, sslErr := credentials.NewClientTLSFromFile(certFile, "")
-
-		if sslErr != nil {
-			log.Fatalf("Error while loading CA trust certificate: %v", sslErr)
-			return
-		}
-
-		opts = grpc.WithTransportCredentials(creds)
+	cfg := config.Client{
+		Address:  "localhost:50051",
+		TLS:      true,
+		CAFile:   "ssl/ca.crt", // Certificate Authority Trust Certificate
+		LogLevel: "warning",
+	}
+	cfg.Register(flag.CommandLine)
+	if err := config.Load(flag.CommandLine, os.Args[1:], "GREET_CLIENT_"); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if err := config.SetupLogging(cfg.LogLevel); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
 	}
 
-	cc, err := grpc.Dial("localhost:50051", opts)
+	fmt.Println("Hello, I'm a client")
+
+	cc, err := cfg.Dial()
 	if err != nil {
 		log.Fatalf("Couldn't connect: %v", err)
 	}
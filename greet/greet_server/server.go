@@ -1,151 +1,57 @@
 package main
 
 import (
+	"flag"
 	"fmt"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/status"
-	"grpc-go-course/greet/greetpb"
-	"io"
+	"grpc-go-course/config"
+	"grpc-go-course/greet/greetservice"
+	"grpc-go-course/runner"
 	"log"
-	"net"
-	"strconv"
+	"os"
 	"time"
 )
 
-type server struct{}
-
-func (*server) Greet(c context.Context, req *greetpb.GreetRequest) (*greetpb.GreetResponse, error){
-
-	fmt.Println("Greet function was invoked with %v", req)
-	firstName := req.GetGreeting().GetFirstName()
-	lastName := req.GetGreeting().GetLastName()
-	result := "Hello, " + firstName + " " + lastName
-	res := &greetpb.GreetResponse{
-		Result:result,
-	}
-	return res, nil
-}
-
-func (*server) GreetManyTimes(req *greetpb.GreetManyTimesRequest, stream greetpb.GreetService_GreetManyTimesServer) error {
-
-	fmt.Println("GreetManyTimes function was invoked with %v", req)
-	firstName := req.GetGreeting().GetFirstName()
-	for i := 0 ; i < 10 ; i++ {
-		result := "Hello, " + firstName + " " + " number " + strconv.Itoa(i)
-		res := &greetpb.GreetManyTimesResponse{
-			Result: result,
-		}
-		stream.Send(res)
-		time.Sleep(1000 * time.Millisecond)
-	}
-	return nil
-
-}
-
-func (*server) LongGreet(stream greetpb.GreetService_LongGreetServer) error {
-
-	fmt.Println("LongGreet function was invoked with a stream request")
-	result := ""
-	for {
-		req, err := stream.Recv()
-		if err == io.EOF {
-			// We have finished reading  the client stream
-			stream.SendAndClose(&greetpb.LongGreetResponse{
-				Result: result,
-			})
-			break
-		}
-		if err != nil {
-			log.Fatalf("Error while reading client stream: %v", err)
-		}
-
-		firstName := req.GetGreeting().GetFirstName()
-		result += "Hello, " + firstName + "! "
-	}
-
-	return nil
-}
-
-func (*server) GreetEveryone(stream greetpb.GreetService_GreetEveryoneServer) error {
-
-	fmt.Println("GreetEveryone function was invoked with a stream request")
-
-	for {
-		req, err := stream.Recv()
-		if err == io.EOF {
-			return nil
-		}
-
-		if err != nil {
-			log.Fatalf("Error while reading client stream: %v", err)
-			return err
-		}
-
-		firstName := req.GetGreeting().GetFirstName()
-		result := "Hello, " + firstName + "! "
-
-		sendErr := stream.Send(&greetpb.GreetEveryoneResponse{
-			Result: result,
-		})
-
-		if sendErr != nil {
-			log.Fatalf("Error while sending data to client : %v", sendErr)
-			return err
-		}
+func main() {
+	cfg := config.Server{
+		Listen:       "0.0.0.0:50051",
+		TLS:          true,
+		CertFile:     "ssl/server.crt",
+		KeyFile:      "ssl/server.pem",
+		DrainDelay:   5 * time.Second,
+		DrainTimeout: 15 * time.Second,
+		LogLevel:     "warning",
 	}
-}
-
-func (*server) GreetWithDeadline(ctx context.Context, req *greetpb.GreetWithDeadlineRequest) (*greetpb.GreetWithDeadlineResponse, error){
-
-	fmt.Println("GreetWithDeadline function was invoked with %v", req)
-
-	for i := 0 ; i < 3 ; i++ {
-		if ctx.Err() == context.Canceled {
-			// the client canceled the request
-			fmt.Println("The client canceled the request")
-			return nil, status.Errorf(codes.Canceled, "The client cancelled the request")
-		}
-		time.Sleep(1 * time.Second)
+	cfg.Register(flag.CommandLine)
+	if err := config.Load(flag.CommandLine, os.Args[1:], "GREET_SERVER_"); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
 	}
-	firstName := req.GetGreeting().GetFirstName()
-	lastName := req.GetGreeting().GetLastName()
-	result := "Hello, " + firstName + " " + lastName
-	res := &greetpb.GreetWithDeadlineResponse{
-		Result:result,
+	if err := config.SetupLogging(cfg.LogLevel); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
 	}
-	return res, nil
-}
 
-func main() {
 	fmt.Println("Hello world")
 
-	lis, err := net.Listen("tcp", "0.0.0.0:50051")
+	lis, err := cfg.NewListener()
 	if err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
 
-	tls := true
-	opts := []grpc.ServerOption{}
-
-	if tls {
-		certFile := "ssl/server.crt"
-		keyFile := "ssl/server.pem"
-		creds, sslErr := credentials.NewServerTLSFromFile(certFile, keyFile)
-		if sslErr != nil {
-			log.Fatalf("Failed loading certificates : %v", sslErr)
-			return
-		}
+	r := runner.New(cfg.DrainDelay, cfg.DrainTimeout)
 
-		opts = append(opts, grpc.Creds(creds))
+	opts, sslErr := cfg.ServerOptions()
+	if sslErr != nil {
+		log.Fatalf("Failed loading certificates : %v", sslErr)
 	}
+	opts = append(opts, r.ServerOptions()...)
 
 	s := grpc.NewServer(opts...)
-	greetpb.RegisterGreetServiceServer(s, &server{})
+	greetservice.Register(s)
+	r.RegisterHealth(s)
+	cfg.RegisterReflection(s)
 
-	if err := s.Serve(lis); err != nil {
+	if err := r.Run(s, lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
+	fmt.Println("End of program")
 }
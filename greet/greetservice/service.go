@@ -0,0 +1,128 @@
+// Package greetservice implements the greet service.
+package greetservice
+
+import (
+	"fmt"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc-go-course/greet/greetpb"
+	"io"
+	"log"
+	"strconv"
+	"time"
+)
+
+// Server implements the greet service
+type Server struct{}
+
+func (*Server) Greet(c context.Context, req *greetpb.GreetRequest) (*greetpb.GreetResponse, error){
+
+	fmt.Println("Greet function was invoked with %v", req)
+	firstName := req.GetGreeting().GetFirstName()
+	lastName := req.GetGreeting().GetLastName()
+	result := "Hello, " + firstName + " " + lastName
+	res := &greetpb.GreetResponse{
+		Result:result,
+	}
+	return res, nil
+}
+
+func (*Server) GreetManyTimes(req *greetpb.GreetManyTimesRequest, stream greetpb.GreetService_GreetManyTimesServer) error {
+
+	fmt.Println("GreetManyTimes function was invoked with %v", req)
+	firstName := req.GetGreeting().GetFirstName()
+	for i := 0 ; i < 10 ; i++ {
+		result := "Hello, " + firstName + " " + " number " + strconv.Itoa(i)
+		res := &greetpb.GreetManyTimesResponse{
+			Result: result,
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+		time.Sleep(1000 * time.Millisecond)
+	}
+	return nil
+
+}
+
+func (*Server) LongGreet(stream greetpb.GreetService_LongGreetServer) error {
+
+	fmt.Println("LongGreet function was invoked with a stream request")
+	result := ""
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			// We have finished reading  the client stream
+			stream.SendAndClose(&greetpb.LongGreetResponse{
+				Result: result,
+			})
+			break
+		}
+		if err != nil {
+			log.Printf("Error while reading client stream: %v", err)
+			return err
+		}
+
+		firstName := req.GetGreeting().GetFirstName()
+		result += "Hello, " + firstName + "! "
+	}
+
+	return nil
+}
+
+func (*Server) GreetEveryone(stream greetpb.GreetService_GreetEveryoneServer) error {
+
+	fmt.Println("GreetEveryone function was invoked with a stream request")
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			log.Printf("Error while reading client stream: %v", err)
+			return err
+		}
+
+		firstName := req.GetGreeting().GetFirstName()
+		result := "Hello, " + firstName + "! "
+
+		sendErr := stream.Send(&greetpb.GreetEveryoneResponse{
+			Result: result,
+		})
+
+		if sendErr != nil {
+			log.Printf("Error while sending data to client : %v", sendErr)
+			return sendErr
+		}
+	}
+}
+
+func (*Server) GreetWithDeadline(ctx context.Context, req *greetpb.GreetWithDeadlineRequest) (*greetpb.GreetWithDeadlineResponse, error){
+
+	fmt.Println("GreetWithDeadline function was invoked with %v", req)
+
+	for i := 0 ; i < 3 ; i++ {
+		if ctx.Err() == context.Canceled {
+			// the client canceled the request
+			fmt.Println("The client canceled the request")
+			return nil, status.Errorf(codes.Canceled, "The client cancelled the request")
+		}
+		time.Sleep(1 * time.Second)
+	}
+	firstName := req.GetGreeting().GetFirstName()
+	lastName := req.GetGreeting().GetLastName()
+	result := "Hello, " + firstName + " " + lastName
+	res := &greetpb.GreetWithDeadlineResponse{
+		Result:result,
+	}
+	return res, nil
+}
+
+// Register registers the greet service on s
+func Register(s *grpc.Server) {
+	greetpb.RegisterGreetServiceServer(s, &Server{})
+}
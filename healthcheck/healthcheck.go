@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"golang.org/x/net/context"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"grpc-go-course/config"
+	"io"
+	"log"
+	"os"
+	"time"
+)
+
+// asks a server for the health of one of its services, or of the whole
+// server, and exits with 0 only when it is serving
+func main() {
+	service := flag.String("service", "", "service to check, like blog.BlogService, the whole server if empty")
+	watch := flag.Bool("watch", false, "print every change of the status until the server stops")
+	cfg := config.Client{
+		Address:  "localhost:50051",
+		CAFile:   "ssl/ca.crt",
+		Timeout:  5 * time.Second,
+		LogLevel: "warning",
+	}
+	cfg.Register(flag.CommandLine)
+	if err := config.Load(flag.CommandLine, os.Args[1:], "HEALTHCHECK_"); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if err := config.SetupLogging(cfg.LogLevel); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
+	cc, err := cfg.Dial()
+	if err != nil {
+		log.Fatalf("Couldn't connect: %v", err)
+	}
+	defer cc.Close()
+	c := healthpb.NewHealthClient(cc)
+	req := &healthpb.HealthCheckRequest{Service: *service}
+
+	if !*watch {
+		res, err := c.Check(context.Background(), req)
+		if err != nil {
+			log.Fatalf("error while calling Check RPC: %v", err)
+		}
+		fmt.Println(res.GetStatus())
+		if res.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			os.Exit(1)
+		}
+		return
+	}
+
+	stream, err := c.Watch(context.Background(), req)
+	if err != nil {
+		log.Fatalf("error while calling Watch RPC: %v", err)
+	}
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Printf("The watch ended: %v", err)
+			break
+		}
+		last = res.GetStatus()
+		fmt.Printf("%v %v\n", time.Now().Format(time.RFC3339), last)
+	}
+	if last != healthpb.HealthCheckResponse_SERVING {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,367 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/jhump/protoreflect/dynamic/grpcdynamic"
+	"github.com/jhump/protoreflect/grpcreflect"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"grpc-go-course/config"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+const usage = `Usage: reflectcli <command> [flags] [args]
+
+Commands:
+  list            list the services of a server
+  describe        list the methods of a service and show their messages
+  call            call a method with JSON messages
+
+The server must serve the reflection service, see its -reflection flag.
+Run "reflectcli <command> -h" to see the flags of a command.
+`
+
+// connection flags shared by every command
+type connFlags struct {
+	config.Client
+}
+
+func (c *connFlags) register(fs *flag.FlagSet) {
+	c.Client = config.Client{
+		Address:  "localhost:50051",
+		CAFile:   "ssl/ca.crt",
+		LogLevel: "warning",
+	}
+	c.Client.Register(fs)
+}
+
+// dial connects to the server and to its reflection service
+func (c *connFlags) dial() (*grpc.ClientConn, *grpcreflect.Client) {
+	if err := config.SetupLogging(c.LogLevel); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
+	cc, err := c.Dial()
+	if err != nil {
+		log.Fatalf("Couldn't connect: %v", err)
+	}
+	return cc, grpcreflect.NewClient(context.Background(), rpb.NewServerReflectionClient(cc))
+}
+
+// parse reads the flags of a command from args, the environment and the
+// config file
+func parse(fs *flag.FlagSet, args []string) {
+	if err := config.Load(fs, args, "REFLECTCLI_"); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+}
+
+// headers holds the metadata given with -header, which can be repeated
+type headers []string
+
+func (h *headers) String() string {
+	return strings.Join(*h, ",")
+}
+
+func (h *headers) Set(value string) error {
+	if !strings.Contains(value, ":") {
+		return fmt.Errorf("expected name:value, got %q", value)
+	}
+	*h = append(*h, value)
+	return nil
+}
+
+// context returns a context sending the headers with a call
+func (h headers) context() context.Context {
+	var pairs []string
+	for _, header := range h {
+		parts := strings.SplitN(header, ":", 2)
+		pairs = append(pairs, strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+	}
+	return metadata.NewOutgoingContext(context.Background(), metadata.Pairs(pairs...))
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	command, args := os.Args[1], os.Args[2:]
+	switch command {
+	case "list":
+		doList(args)
+	case "describe":
+		doDescribe(args)
+	case "call":
+		doCall(args)
+	case "help", "-h", "-help", "--help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n%v", command, usage)
+		os.Exit(2)
+	}
+}
+
+func doList(args []string) {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	var conn connFlags
+	conn.register(fs)
+	parse(fs, args)
+
+	cc, rc := conn.dial()
+	defer cc.Close()
+	defer rc.Reset()
+
+	services, err := rc.ListServices()
+	if err != nil {
+		log.Fatalf("error while listing services: %v", err)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		fmt.Println(service)
+	}
+}
+
+func doDescribe(args []string) {
+	fs := flag.NewFlagSet("describe", flag.ExitOnError)
+	var conn connFlags
+	conn.register(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: reflectcli describe [flags] <service>\n")
+		fs.PrintDefaults()
+	}
+	parse(fs, args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	cc, rc := conn.dial()
+	defer cc.Close()
+	defer rc.Reset()
+
+	sd, err := rc.ResolveService(fs.Arg(0))
+	if err != nil {
+		log.Fatalf("Cannot find service %v: %v", fs.Arg(0), err)
+	}
+
+	fmt.Printf("service %v {\n", sd.GetFullyQualifiedName())
+	messages := make(map[string]*desc.MessageDescriptor)
+	for _, md := range sd.GetMethods() {
+		fmt.Printf("  rpc %v(%v) returns (%v);\n", md.GetName(),
+			typeName(md.GetInputType(), md.IsClientStreaming()),
+			typeName(md.GetOutputType(), md.IsServerStreaming()))
+		messages[md.GetInputType().GetFullyQualifiedName()] = md.GetInputType()
+		messages[md.GetOutputType().GetFullyQualifiedName()] = md.GetOutputType()
+	}
+	fmt.Println("}")
+
+	names := make([]string, 0, len(messages))
+	for name := range messages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Printf("\nmessage %v {\n", name)
+		for _, field := range messages[name].GetFields() {
+			fmt.Printf("  %v%v %v = %v;\n", label(field), fieldType(field), field.GetName(), field.GetNumber())
+		}
+		fmt.Println("}")
+	}
+}
+
+// typeName writes a message type the way it appears in a method
+func typeName(md *desc.MessageDescriptor, stream bool) string {
+	if stream {
+		return "stream " + md.GetFullyQualifiedName()
+	}
+	return md.GetFullyQualifiedName()
+}
+
+// label returns the label of a repeated field
+func label(fd *desc.FieldDescriptor) string {
+	if fd.IsRepeated() && !fd.IsMap() {
+		return "repeated "
+	}
+	return ""
+}
+
+// fieldType returns the type of a field the way it appears in a .proto file
+func fieldType(fd *desc.FieldDescriptor) string {
+	if fd.IsMap() {
+		return fmt.Sprintf("map<%v, %v>", fieldType(fd.GetMapKeyType()), fieldType(fd.GetMapValueType()))
+	}
+	if md := fd.GetMessageType(); md != nil {
+		return md.GetFullyQualifiedName()
+	}
+	if ed := fd.GetEnumType(); ed != nil {
+		return ed.GetFullyQualifiedName()
+	}
+	return strings.ToLower(strings.TrimPrefix(fd.GetType().String(), "TYPE_"))
+}
+
+func doCall(args []string) {
+	fs := flag.NewFlagSet("call", flag.ExitOnError)
+	var conn connFlags
+	conn.register(fs)
+	data := fs.String("data", "", "JSON request, read from stdin one message per line if empty")
+	var hdrs headers
+	fs.Var(&hdrs, "header", "name:value metadata sent with the call, can be repeated")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: reflectcli call [flags] <service>/<method>\n")
+		fs.PrintDefaults()
+	}
+	parse(fs, args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	i := strings.LastIndexAny(fs.Arg(0), "/.")
+	if i <= 0 {
+		log.Fatalf("Expected <service>/<method>, got %q", fs.Arg(0))
+	}
+	serviceName, methodName := fs.Arg(0)[:i], fs.Arg(0)[i+1:]
+
+	cc, rc := conn.dial()
+	defer cc.Close()
+	defer rc.Reset()
+
+	sd, err := rc.ResolveService(serviceName)
+	if err != nil {
+		log.Fatalf("Cannot find service %v: %v", serviceName, err)
+	}
+	md := sd.FindMethodByName(methodName)
+	if md == nil {
+		log.Fatalf("Service %v has no method %v", serviceName, methodName)
+	}
+
+	requests := readRequests(md.GetInputType(), *data)
+	ctx := hdrs.context()
+	stub := grpcdynamic.NewStub(cc)
+	m := jsonpb.Marshaler{OrigName: true, Indent: "  "}
+	show := func(res proto.Message) {
+		out, err := m.MarshalToString(res)
+		if err != nil {
+			log.Fatalf("error while writing response: %v", err)
+		}
+		fmt.Println(out)
+	}
+
+	switch {
+	case md.IsClientStreaming() && md.IsServerStreaming():
+		stream, err := stub.InvokeRpcBidiStream(ctx, md)
+		if err != nil {
+			log.Fatalf("error while calling %v: %v", md.GetName(), err)
+		}
+		go func() {
+			for req := range requests {
+				if err := stream.SendMsg(req); err != nil {
+					// the reason comes with the response
+					break
+				}
+			}
+			stream.CloseSend()
+		}()
+		receive(stream.RecvMsg, show)
+	case md.IsClientStreaming():
+		stream, err := stub.InvokeRpcClientStream(ctx, md)
+		if err != nil {
+			log.Fatalf("error while calling %v: %v", md.GetName(), err)
+		}
+		for req := range requests {
+			if err := stream.SendMsg(req); err != nil {
+				break
+			}
+		}
+		res, err := stream.CloseAndReceive()
+		if err != nil {
+			log.Fatalf("error while calling %v: %v", md.GetName(), err)
+		}
+		show(res)
+	case md.IsServerStreaming():
+		stream, err := stub.InvokeRpcServerStream(ctx, md, single(requests))
+		if err != nil {
+			log.Fatalf("error while calling %v: %v", md.GetName(), err)
+		}
+		receive(stream.RecvMsg, show)
+	default:
+		res, err := stub.InvokeRpc(ctx, md, single(requests))
+		if err != nil {
+			log.Fatalf("error while calling %v: %v", md.GetName(), err)
+		}
+		show(res)
+	}
+}
+
+// readRequests sends on the returned channel the request given with -data,
+// or else every JSON message read from stdin, one per line
+func readRequests(md *desc.MessageDescriptor, data string) <-chan proto.Message {
+	requests := make(chan proto.Message)
+	decode := func(text string) proto.Message {
+		req := dynamic.NewMessage(md)
+		if err := req.UnmarshalJSON([]byte(text)); err != nil {
+			log.Fatalf("Invalid %v: %v", md.GetFullyQualifiedName(), err)
+		}
+		return req
+	}
+
+	go func() {
+		defer close(requests)
+		if data != "" {
+			requests <- decode(data)
+			return
+		}
+		scanner := bufio.NewScanner(os.Stdin)
+		scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+		for scanner.Scan() {
+			if text := strings.TrimSpace(scanner.Text()); text != "" {
+				requests <- decode(text)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			log.Fatalf("error while reading requests: %v", err)
+		}
+	}()
+	return requests
+}
+
+// single returns the only request of a call that takes one
+func single(requests <-chan proto.Message) proto.Message {
+	req, ok := <-requests
+	if !ok {
+		log.Fatalf("Missing request, give it with -data or on stdin")
+	}
+	for range requests {
+		log.Fatalf("The method takes a single request")
+	}
+	return req
+}
+
+// receive prints the responses of a stream until it ends
+func receive(recv func() (proto.Message, error), show func(proto.Message)) {
+	for {
+		res, err := recv()
+		if err == io.EOF {
+			// we've reached the end of the stream
+			return
+		}
+		if err != nil {
+			log.Fatalf("error while reading stream: %v", err)
+		}
+		show(res)
+	}
+}
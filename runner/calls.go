@@ -0,0 +1,86 @@
+package runner
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// Call describes an RPC being served
+type Call struct {
+	Method string
+	// Stream is true for streaming RPCs
+	Stream  bool
+	Peer    string
+	Started time.Time
+}
+
+// calls keeps track of the RPCs being served
+type calls struct {
+	mu     sync.Mutex
+	nextID uint64
+	active map[uint64]Call
+}
+
+// begin records the start of a call and returns the function to call
+// when it ends
+func (c *calls) begin(ctx context.Context, method string, stream bool) func() {
+	call := Call{
+		Method:  method,
+		Stream:  stream,
+		Peer:    "unknown",
+		Started: time.Now(),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		call.Peer = p.Addr.String()
+	}
+
+	c.mu.Lock()
+	if c.active == nil {
+		c.active = make(map[uint64]Call)
+	}
+	id := c.nextID
+	c.nextID++
+	c.active[id] = call
+	c.mu.Unlock()
+
+	return func() {
+		c.mu.Lock()
+		delete(c.active, id)
+		c.mu.Unlock()
+	}
+}
+
+// snapshot returns the calls being served, oldest first
+func (c *calls) snapshot() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result := make([]Call, 0, len(c.active))
+	for _, call := range c.active {
+		result = append(result, call)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Started.Before(result[j].Started)
+	})
+	return result
+}
+
+// UnaryServerInterceptor keeps track of the unary calls being served, so
+// the ones cut by a forced stop can be reported
+func (r *Runner) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	end := r.calls.begin(ctx, info.FullMethod, false)
+	defer end()
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor keeps track of the streams being served, so the
+// ones cut by a forced stop can be reported
+func (r *Runner) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	end := r.calls.begin(ss.Context(), info.FullMethod, true)
+	defer end()
+	return handler(srv, ss)
+}
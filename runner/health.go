@@ -0,0 +1,116 @@
+package runner
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterHealth registers the standard health service on s and reports
+// the services registered so far as serving. It must be called after
+// registering the other services. Every service is reported as not
+// serving once the server starts stopping, and the Watch streams end
+// after telling their client so.
+//
+// The status of a service can be changed with the returned server.
+func (r *Runner) RegisterHealth(s *grpc.Server) *health.Server {
+	hs := health.NewServer()
+	for name := range s.GetServiceInfo() {
+		hs.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+
+	h := &healthServer{
+		Server:  hs,
+		drained: make(chan struct{}),
+	}
+	healthpb.RegisterHealthServer(s, h)
+	r.OnDrain(func() {
+		hs.Shutdown()
+		close(h.drained)
+	})
+	return hs
+}
+
+// healthServer ends the Watch streams of the health server when the server
+// drains, since they never stop on their own
+type healthServer struct {
+	*health.Server
+	drained chan struct{}
+}
+
+func (h *healthServer) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	w := &watchStream{
+		Health_WatchServer: stream,
+		ctx:                ctx,
+		last:               healthpb.HealthCheckResponse_SERVING,
+		sent:               make(chan struct{}, 1),
+	}
+
+	go func() {
+		select {
+		case <-h.drained:
+		case <-ctx.Done():
+			return
+		}
+		// the client is told the service stopped serving before the
+		// stream ends
+		for w.serving() {
+			select {
+			case <-w.sent:
+			case <-ctx.Done():
+				return
+			}
+		}
+		cancel()
+	}()
+
+	err := h.Server.Watch(req, w)
+	select {
+	case <-h.drained:
+		return status.Errorf(codes.Unavailable, "The server is stopping")
+	default:
+		return err
+	}
+}
+
+// watchStream remembers the last status sent to a watcher
+type watchStream struct {
+	healthpb.Health_WatchServer
+	ctx context.Context
+
+	mu   sync.Mutex
+	last healthpb.HealthCheckResponse_ServingStatus
+	sent chan struct{}
+}
+
+func (w *watchStream) Context() context.Context {
+	return w.ctx
+}
+
+func (w *watchStream) Send(res *healthpb.HealthCheckResponse) error {
+	if err := w.Health_WatchServer.Send(res); err != nil {
+		return err
+	}
+	w.mu.Lock()
+	w.last = res.GetStatus()
+	w.mu.Unlock()
+	select {
+	case w.sent <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// serving tells whether the watcher was last told the service is serving
+func (w *watchStream) serving() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.last == healthpb.HealthCheckResponse_SERVING
+}
@@ -0,0 +1,150 @@
+// Package runner serves a gRPC server until the process is asked to stop,
+// then lets the calls in progress finish before exiting.
+package runner
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// Runner serves a gRPC server until SIGINT or SIGTERM is received, then
+// reports it is stopping, keeps accepting calls for the drain delay so
+// health checkers notice, and stops accepting calls and waits for the
+// calls in progress to finish.
+// Calls still running after the drain timeout, or when a second signal is
+// received, are cut and reported.
+//
+// Its interceptors must be installed on the server for the cut calls to
+// be reported.
+type Runner struct {
+	drainDelay   time.Duration
+	drainTimeout time.Duration
+	calls        calls
+	onDrain      []func()
+}
+
+// New returns a runner accepting calls for drainDelay after reporting it is
+// stopping, then waiting at most drainTimeout for the calls in progress
+func New(drainDelay time.Duration, drainTimeout time.Duration) *Runner {
+	return &Runner{
+		drainDelay:   drainDelay,
+		drainTimeout: drainTimeout,
+	}
+}
+
+// ServerOptions returns the options installing the interceptors of the
+// runner, for servers without interceptors of their own
+func (r *Runner) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(r.UnaryServerInterceptor),
+		grpc.StreamInterceptor(r.StreamServerInterceptor),
+	}
+}
+
+// OnDrain registers f to be called when the server starts stopping, a drain
+// delay before it stops accepting calls, to report the server as going away
+// while it still answers and to end the calls that would otherwise never
+// finish, like watch streams
+func (r *Runner) OnDrain(f func()) {
+	r.onDrain = append(r.onDrain, f)
+}
+
+// Run serves s on lis until a stop signal is received and the server is
+// stopped. It returns the error of Serve if serving fails.
+func (r *Runner) Run(s *grpc.Server, lis net.Listener) error {
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	served := make(chan error, 1)
+	go func() {
+		fmt.Println("Starting server....")
+		served <- s.Serve(lis)
+	}()
+
+	select {
+	case err := <-served:
+		return err
+	case sig := <-signals:
+		fmt.Printf("Received %v, stopping the server\n", sig)
+	}
+
+	r.stop(s, signals)
+	<-served
+	return nil
+}
+
+// stop stops s gracefully, and forcefully after the drain timeout or when
+// another signal is received
+func (r *Runner) stop(s *grpc.Server, signals <-chan os.Signal) {
+	// the hooks run first and the server keeps accepting calls for the
+	// drain delay, so health checkers polling on an interval see the
+	// server going away before it refuses new connections
+	for _, f := range r.onDrain {
+		f()
+	}
+	if r.drainDelay > 0 {
+		fmt.Printf("Reported as not serving, accepting calls for %v more, send the signal again to stop now\n", r.drainDelay)
+		delay := time.NewTimer(r.drainDelay)
+		select {
+		case <-delay.C:
+		case sig := <-signals:
+			delay.Stop()
+			fmt.Printf("Received %v again, stopping now\n", sig)
+			r.stopNow(s)
+			return
+		}
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(drained)
+	}()
+
+	if pending := len(r.calls.snapshot()); pending > 0 {
+		fmt.Printf("Waiting up to %v for %v calls to finish, send the signal again to stop now\n", r.drainTimeout, pending)
+	}
+
+	timer := time.NewTimer(r.drainTimeout)
+	defer timer.Stop()
+	select {
+	case <-drained:
+		fmt.Println("Every call finished")
+		return
+	case <-timer.C:
+		fmt.Println("Drain timeout reached, stopping now")
+	case sig := <-signals:
+		fmt.Printf("Received %v again, stopping now\n", sig)
+	}
+
+	r.stopNow(s)
+	<-drained
+}
+
+// stopNow stops s without waiting for the calls in progress, and reports
+// the calls it cuts
+func (r *Runner) stopNow(s *grpc.Server) {
+	aborted := r.calls.snapshot()
+	s.Stop()
+	report(aborted)
+}
+
+// report prints the calls cut by a forced stop
+func report(aborted []Call) {
+	fmt.Printf("Aborted %v calls\n", len(aborted))
+	for _, call := range aborted {
+		kind := "call"
+		if call.Stream {
+			kind = "stream"
+		}
+		fmt.Printf("  %v %v from %v, running for %v\n",
+			kind, call.Method, call.Peer, time.Since(call.Started).Round(time.Millisecond))
+	}
+}
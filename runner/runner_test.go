@@ -0,0 +1,87 @@
+package runner
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// checkHealth asks the overall health of the server at addr over a new
+// connection, so it fails once the server stops accepting connections
+func checkHealth(addr string) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	res, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return res.GetStatus(), nil
+}
+
+func TestStopReportsNotServingBeforeRefusingCalls(t *testing.T) {
+	const drainDelay = time.Second
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := New(drainDelay, time.Second)
+	s := grpc.NewServer(r.ServerOptions()...)
+	r.RegisterHealth(s)
+	served := make(chan error, 1)
+	go func() {
+		served <- s.Serve(lis)
+	}()
+	addr := lis.Addr().String()
+
+	if st, err := checkHealth(addr); err != nil || st != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("health before stopping = %v, %v, want SERVING", st, err)
+	}
+
+	start := time.Now()
+	stopped := make(chan struct{})
+	go func() {
+		r.stop(s, nil)
+		close(stopped)
+	}()
+
+	for {
+		st, err := checkHealth(addr)
+		if err != nil {
+			t.Fatalf("server refused calls before reporting NOT_SERVING: %v", err)
+		}
+		if st == healthpb.HealthCheckResponse_NOT_SERVING {
+			break
+		}
+		if time.Since(start) > drainDelay {
+			t.Fatal("server never reported NOT_SERVING")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// a checker polling a little later still reaches the server
+	time.Sleep(drainDelay / 2)
+	if st, err := checkHealth(addr); err != nil || st != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("health during the drain delay = %v, %v, want NOT_SERVING", st, err)
+	}
+
+	<-stopped
+	if elapsed := time.Since(start); elapsed < drainDelay {
+		t.Errorf("server stopped after %v, before the drain delay of %v", elapsed, drainDelay)
+	}
+	if err := <-served; err != nil {
+		t.Errorf("Serve: %v", err)
+	}
+	if _, err := checkHealth(addr); err == nil {
+		t.Error("server still accepts calls after stopping")
+	}
+}
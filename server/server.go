@@ -0,0 +1,114 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"google.golang.org/grpc"
+	"grpc-go-course/blog/blogservice"
+	"grpc-go-course/calculator/calculatorservice"
+	"grpc-go-course/config"
+	"grpc-go-course/greet/greetservice"
+	"grpc-go-course/runner"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+// serves the greet, calculator and blog services on one port, each of them
+// can be turned off
+func main() {
+	//if we crash the go code, we get the file name and line number
+	log.SetFlags(log.LstdFlags | log.Lshortfile)
+
+	greet := flag.Bool("greet", true, "serve the greet service")
+	calculator := flag.Bool("calculator", true, "serve the calculator service")
+	blog := flag.Bool("blog", true, "serve the blog service")
+	blogCfg := blogservice.DefaultConfig()
+	blogCfg.Register(flag.CommandLine)
+	cfg := config.Server{
+		Listen:       "0.0.0.0:50051",
+		CertFile:     "ssl/server.crt",
+		KeyFile:      "ssl/server.pem",
+		DrainDelay:   5 * time.Second,
+		DrainTimeout: 15 * time.Second,
+		LogLevel:     "warning",
+	}
+	cfg.Register(flag.CommandLine)
+	if err := config.Load(flag.CommandLine, os.Args[1:], "SERVER_"); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if err := config.SetupLogging(cfg.LogLevel); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if !*greet && !*calculator && !*blog {
+		log.Fatalf("Invalid configuration: every service is turned off")
+	}
+
+	r := runner.New(cfg.DrainDelay, cfg.DrainTimeout)
+	unary := []grpc.UnaryServerInterceptor{
+		r.UnaryServerInterceptor,
+	}
+	stream := []grpc.StreamServerInterceptor{
+		r.StreamServerInterceptor,
+	}
+
+	var blogService *blogservice.Service
+	if *blog {
+		var err error
+		blogService, err = blogservice.Open(blogCfg)
+		if err != nil {
+			log.Fatalf("Failed to start the blog service: %v", err)
+		}
+		// the blog interceptors leave the calls to other services alone
+		unary = append(unary, blogService.UnaryServerInterceptor)
+		stream = append(stream, blogService.StreamServerInterceptor)
+	}
+
+	lis, err := cfg.NewListener()
+	if err != nil {
+		log.Fatalf("Failed to serve: %v", err)
+	}
+
+	opts, sslErr := cfg.ServerOptions()
+	if sslErr != nil {
+		log.Fatalf("Failed loading certificates : %v", sslErr)
+	}
+	opts = append(opts,
+		grpc.UnaryInterceptor(runner.ChainUnary(unary...)),
+		grpc.StreamInterceptor(runner.ChainStream(stream...)),
+	)
+
+	s := grpc.NewServer(opts...)
+	var services []string
+	if *greet {
+		greetservice.Register(s)
+		services = append(services, "greet")
+	}
+	if *calculator {
+		calculatorservice.Register(s)
+		services = append(services, "calculator")
+	}
+	if blogService != nil {
+		blogService.Register(s)
+		services = append(services, "blog")
+	}
+	hs := r.RegisterHealth(s)
+	cfg.RegisterReflection(s)
+	if blogService != nil {
+		blogService.ReportHealth(hs)
+		blogService.Start()
+		r.OnDrain(blogService.Drain)
+	}
+	fmt.Printf("Serving %v on %v\n", strings.Join(services, ", "), cfg.Listen)
+
+	if err := r.Run(s, lis); err != nil {
+		log.Fatalf("Failed to serve: %v", err)
+	}
+	if blogService != nil {
+		if err := blogService.Close(); err != nil {
+			log.Printf("Failed to close the blog storage: %v", err)
+		}
+	}
+	fmt.Println("End of program")
+}